@@ -0,0 +1,66 @@
+package job
+
+import (
+	"time"
+)
+
+// Backoff ticks according to the strategy, while payload succeeds.
+// After payload failure it ticks with delay, that starts from initial,
+// grows by multiplier after every consecutive failure and is limited by max.
+// Successful execution resets the delay and returns to the strategy.
+// Delay does not shrink, when multiplier is less than 1, and is at least one second, when initial or max are not positive.
+func Backoff(initial time.Duration, multiplier float64, max time.Duration, strategy Strategy) *BackoffStrategy {
+	return &BackoffStrategy{
+		initial:    initial,
+		multiplier: multiplier,
+		max:        max,
+		strategy:   strategy,
+		delay:      0,
+	}
+}
+
+var _ ResultStrategy = (*BackoffStrategy)(nil)
+
+type BackoffStrategy struct {
+	initial    time.Duration
+	multiplier float64
+	max        time.Duration
+	strategy   Strategy
+	delay      time.Duration
+}
+
+func (s *BackoffStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return s.TickResult(lastTickTime, nil)
+}
+
+func (s *BackoffStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	if err == nil {
+		s.delay = 0
+		return tickResult(s.strategy, lastTickTime, nil)
+	}
+	s.delay = s.nextDelay()
+	return time.Now().Add(s.delay)
+}
+
+// minBackoffDelay protects from retrying failed payload in a loop, when delay settings are not positive.
+const minBackoffDelay = time.Second
+
+func (s *BackoffStrategy) nextDelay() (delay time.Duration) {
+	delay = s.initial
+	if s.delay != 0 && float64(s.delay)*s.multiplier < float64(s.max) {
+		delay = time.Duration(float64(s.delay) * s.multiplier)
+	} else if s.delay != 0 {
+		delay = s.max
+	}
+	if delay < s.initial {
+		// multiplier is less than 1
+		delay = s.initial
+	}
+	if delay > s.max {
+		delay = s.max
+	}
+	if delay <= 0 {
+		delay = minBackoffDelay
+	}
+	return delay
+}
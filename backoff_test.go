@@ -0,0 +1,67 @@
+package job
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OnBackoffStrategyTickAfterSuccess_ShouldReturnTickTimeUsingSpecifiedStrategy(t *testing.T) {
+	lastTickTime := time.Now().Add(-2 * time.Second)
+	strategy := Backoff(time.Second, 2, time.Minute, Interval(time.Hour))
+	nextTickTime := strategy.TickResult(lastTickTime, nil)
+	assert.Equal(t, lastTickTime.Add(time.Hour), nextTickTime)
+}
+
+func Test_OnBackoffStrategyTickAfterConsecutiveFailures_ShouldIncreaseDelayUpToMax(t *testing.T) {
+	lastTickTime := time.Now().Add(-2 * time.Second)
+	strategy := Backoff(time.Second, 2, 5*time.Second, Interval(time.Hour))
+	for _, delay := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		nextTickTime := strategy.TickResult(lastTickTime, errors.New("failure"))
+		assert.InDelta(t, time.Now().Add(delay).UnixNano(), nextTickTime.UnixNano(), float64(10*time.Millisecond))
+	}
+}
+
+func Test_OnBackoffStrategyTickAfterSuccessFollowingFailures_ShouldResetDelay(t *testing.T) {
+	lastTickTime := time.Now().Add(-2 * time.Second)
+	strategy := Backoff(time.Second, 2, time.Minute, Interval(time.Hour))
+	_ = strategy.TickResult(lastTickTime, errors.New("failure"))
+	_ = strategy.TickResult(lastTickTime, errors.New("failure"))
+	nextTickTime := strategy.TickResult(lastTickTime, nil)
+	assert.Equal(t, lastTickTime.Add(time.Hour), nextTickTime)
+	nextTickTime = strategy.TickResult(lastTickTime, errors.New("failure"))
+	assert.InDelta(t, time.Now().Add(time.Second).UnixNano(), nextTickTime.UnixNano(), float64(10*time.Millisecond))
+}
+
+func Test_OnWrappedBackoffStrategyTick_ShouldReceivePayloadExecutionResult(t *testing.T) {
+	lastTickTime := time.Now().Add(-2 * time.Second)
+	strategy := Delay(0, Backoff(time.Second, 2, time.Minute, Interval(time.Hour)))
+	_ = strategy.Tick(lastTickTime)
+	nextTickTime := strategy.TickResult(lastTickTime, errors.New("failure"))
+	assert.InDelta(t, time.Now().Add(time.Second).UnixNano(), nextTickTime.UnixNano(), float64(10*time.Millisecond))
+}
+
+func Test_OnBackoffStrategyTickWithNonPositiveDelays_ShouldNotRetryImmediately(t *testing.T) {
+	lastTickTime := time.Now().Add(-2 * time.Second)
+	for _, strategy := range []*BackoffStrategy{
+		Backoff(0, 2, 0, Interval(time.Hour)),
+		Backoff(time.Second, 2, 0, Interval(time.Hour)),
+		Backoff(-time.Second, 2, -time.Second, Interval(time.Hour)),
+	} {
+		for i := 0; i < 3; i++ {
+			nextTickTime := strategy.TickResult(lastTickTime, errors.New("failure"))
+			assert.InDelta(t, time.Now().Add(minBackoffDelay).UnixNano(), nextTickTime.UnixNano(), float64(10*time.Millisecond))
+		}
+	}
+}
+
+func Test_OnBackoffStrategyTickWithMultiplierLessThanOne_ShouldNotDecreaseDelay(t *testing.T) {
+	lastTickTime := time.Now().Add(-2 * time.Second)
+	strategy := Backoff(time.Second, 0.5, time.Minute, Interval(time.Hour))
+	for i := 0; i < 3; i++ {
+		nextTickTime := strategy.TickResult(lastTickTime, errors.New("failure"))
+		assert.InDelta(t, time.Now().Add(time.Second).UnixNano(), nextTickTime.UnixNano(), float64(10*time.Millisecond))
+	}
+}
@@ -0,0 +1,339 @@
+package job
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type Calendar interface {
+	IsBusinessDay(date time.Time) (ok bool)
+}
+
+type civilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dateOf(dt time.Time) (date civilDate) {
+	year, month, day := dt.Date()
+	return civilDate{year: year, month: month, day: day}
+}
+
+// Holidays creates calendar, where business days are all days except weekend (Saturday and Sunday) and holidays.
+func Holidays(holidays ...time.Time) *HolidayCalendar {
+	c := &HolidayCalendar{
+		weekend:  []time.Weekday{time.Saturday, time.Sunday},
+		holidays: map[civilDate]struct{}{},
+	}
+	c.Add(holidays...)
+	return c
+}
+
+var _ Calendar = (*HolidayCalendar)(nil)
+
+type HolidayCalendar struct {
+	weekend  []time.Weekday
+	holidays map[civilDate]struct{}
+}
+
+func (c *HolidayCalendar) WithWeekend(weekend ...time.Weekday) *HolidayCalendar {
+	c.weekend = weekend
+	return c
+}
+
+func (c *HolidayCalendar) Add(holidays ...time.Time) {
+	for _, holiday := range holidays {
+		c.holidays[dateOf(holiday)] = struct{}{}
+	}
+}
+
+func (c *HolidayCalendar) IsBusinessDay(date time.Time) (ok bool) {
+	for _, weekday := range c.weekend {
+		if date.Weekday() == weekday {
+			return false
+		}
+	}
+	_, holiday := c.holidays[dateOf(date)]
+	return !holiday
+}
+
+const holidayDateLayout = "2006-01-02"
+
+// LoadHolidaysCSV loads holidays from CSV, where the first column contains date in YYYY-MM-DD format.
+// Other columns and optional header are ignored.
+func LoadHolidaysCSV(r io.Reader) (calendar *HolidayCalendar, err error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	calendar = Holidays()
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return calendar, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		date, err := time.Parse(holidayDateLayout, strings.TrimSpace(record[0]))
+		if err != nil && line == 1 {
+			// header
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("holidays csv: line %d: %w", line, err)
+		}
+		calendar.Add(date)
+	}
+}
+
+// LoadHolidaysICal loads holidays from events of iCalendar (RFC 5545).
+// Every day from DTSTART until DTEND (exclusive) of the event is holiday.
+func LoadHolidaysICal(r io.Reader) (calendar *HolidayCalendar, err error) {
+	lines, err := unfoldICalLines(r)
+	if err != nil {
+		return nil, err
+	}
+	calendar = Holidays()
+	var start, end time.Time
+	inEvent := false
+	for _, line := range lines {
+		name, value := splitICalLine(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			inEvent = true
+			start, end = time.Time{}, time.Time{}
+		case name == "END" && value == "VEVENT":
+			if start.IsZero() {
+				return nil, errors.New("holidays ical: event without DTSTART")
+			}
+			calendar.Add(start)
+			for day := start.AddDate(0, 0, 1); day.Before(end); day = day.AddDate(0, 0, 1) {
+				calendar.Add(day)
+			}
+			inEvent = false
+		case inEvent && (name == "DTSTART" || name == "DTEND"):
+			date, err := parseICalDate(value)
+			if err != nil {
+				return nil, fmt.Errorf("holidays ical: %s: %w", name, err)
+			}
+			if name == "DTSTART" {
+				start = date
+			} else {
+				end = date
+			}
+		}
+	}
+	return calendar, nil
+}
+
+func unfoldICalLines(r io.Reader) (lines []string, err error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) != 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// splitICalLine returns property name without parameters and property value.
+func splitICalLine(line string) (name string, value string) {
+	property, value, _ := strings.Cut(line, ":")
+	name, _, _ = strings.Cut(property, ";")
+	return strings.ToUpper(strings.TrimSpace(name)), strings.TrimSpace(value)
+}
+
+func parseICalDate(value string) (date time.Time, err error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return time.Parse("20060102", value[:8])
+}
+
+// BusinessDays skips the strategy ticks, that are not at business days.
+func BusinessDays(calendar Calendar, strategy Strategy) BusinessDaysStrategy {
+	return BusinessDaysStrategy{
+		calendar: calendar,
+		strategy: strategy,
+	}
+}
+
+var _ ResultStrategy = (*BusinessDaysStrategy)(nil)
+
+type BusinessDaysStrategy struct {
+	calendar Calendar
+	strategy Strategy
+}
+
+func (s BusinessDaysStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return s.skip(s.strategy.Tick(lastTickTime))
+}
+
+func (s BusinessDaysStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	return s.skip(tickResult(s.strategy, lastTickTime, err))
+}
+
+func (s BusinessDaysStrategy) skip(tickTime time.Time) (nextTickTime time.Time) {
+	for i := 0; i < combinatorSearchLimit; i++ {
+		if tickTime.IsZero() || s.calendar.IsBusinessDay(tickTime) {
+			return tickTime
+		}
+		tickTime = s.strategy.Tick(tickTime)
+	}
+	return time.Time{}
+}
+
+// Adjustment defines how tick at non-business day is moved to business day.
+type Adjustment int
+
+const (
+	// Following moves tick to the next business day.
+	Following Adjustment = iota
+	// Preceding moves tick to the previous business day.
+	Preceding
+	// ModifiedFollowing moves tick to the next business day,
+	// unless it is in the next month, then tick is moved to the previous business day.
+	ModifiedFollowing
+)
+
+// Adjust moves the strategy ticks at non-business days to business days according to adjustment.
+func Adjust(calendar Calendar, adjustment Adjustment, strategy Strategy) *AdjustStrategy {
+	return &AdjustStrategy{
+		calendar:   calendar,
+		adjustment: adjustment,
+		strategy:   strategy,
+	}
+}
+
+var _ ResultStrategy = (*AdjustStrategy)(nil)
+
+type AdjustStrategy struct {
+	calendar         Calendar
+	adjustment       Adjustment
+	strategy         Strategy
+	tickTime         time.Time
+	adjustedTickTime time.Time
+}
+
+func (s *AdjustStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return s.adjust(lastTickTime, s.strategy.Tick(s.underlyingTickTime(lastTickTime)))
+}
+
+func (s *AdjustStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	return s.adjust(lastTickTime, tickResult(s.strategy, s.underlyingTickTime(lastTickTime), err))
+}
+
+// underlyingTickTime allows underlying strategy to continue from its own tick time,
+// so tick moved to the preceding day is not repeated.
+func (s *AdjustStrategy) underlyingTickTime(lastTickTime time.Time) (tickTime time.Time) {
+	if !s.adjustedTickTime.IsZero() && lastTickTime.Equal(s.adjustedTickTime) {
+		return s.tickTime
+	}
+	return lastTickTime
+}
+
+// adjust moves tick time of the underlying strategy to business day,
+// ticks, that are moved to the same time or before the last tick, are skipped.
+func (s *AdjustStrategy) adjust(lastTickTime time.Time, tickTime time.Time) (nextTickTime time.Time) {
+	for i := 0; i < combinatorSearchLimit; i++ {
+		if tickTime.IsZero() {
+			return tickTime
+		}
+		adjustedTickTime := s.adjustDay(tickTime)
+		if !adjustedTickTime.IsZero() && adjustedTickTime.After(lastTickTime) {
+			s.tickTime = tickTime
+			s.adjustedTickTime = adjustedTickTime
+			return adjustedTickTime
+		}
+		tickTime = s.strategy.Tick(tickTime)
+	}
+	return time.Time{}
+}
+
+func (s *AdjustStrategy) adjustDay(dt time.Time) (adjusted time.Time) {
+	switch s.adjustment {
+	case Preceding:
+		return moveToBusinessDay(s.calendar, dt, -1)
+	case ModifiedFollowing:
+		adjusted = moveToBusinessDay(s.calendar, dt, 1)
+		if adjusted.Month() != dt.Month() {
+			return moveToBusinessDay(s.calendar, dt, -1)
+		}
+		return adjusted
+	default:
+		return moveToBusinessDay(s.calendar, dt, 1)
+	}
+}
+
+func moveToBusinessDay(calendar Calendar, dt time.Time, direction int) (moved time.Time) {
+	year, month, day := dt.Date()
+	hour, minute, second := dt.Clock()
+	for i := 0; i < calendarSearchDays; i++ {
+		moved = time.Date(year, month, day+i*direction, hour, minute, second, dt.Nanosecond(), dt.Location())
+		if calendar.IsBusinessDay(moved) {
+			return moved
+		}
+	}
+	return time.Time{}
+}
+
+// NthBusinessDay ticks monthly at n-th business day of the month.
+// If n is negative, business days are counted from the end of the month.
+func NthBusinessDay(calendar Calendar, n int, hour int, minute int, second int) NthBusinessDayStrategy {
+	return NthBusinessDayStrategy{
+		calendar: calendar,
+		n:        n,
+		hour:     hour,
+		minute:   minute,
+		second:   second,
+		policy:   dstPolicy{gap: GapShift, overlap: OverlapFirst},
+	}
+}
+
+var _ Strategy = (*NthBusinessDayStrategy)(nil)
+
+type NthBusinessDayStrategy struct {
+	calendar Calendar
+	n        int
+	hour     int
+	minute   int
+	second   int
+	policy   dstPolicy
+}
+
+func (s NthBusinessDayStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return nextCalendarTime(lastTickTime, func(date time.Time) (ok bool) {
+		return s.isNthBusinessDay(date)
+	}, []wallClock{clock(s.hour, s.minute, s.second)}, s.policy)
+}
+
+func (s NthBusinessDayStrategy) isNthBusinessDay(date time.Time) (ok bool) {
+	if s.n == 0 || !s.calendar.IsBusinessDay(date) {
+		return false
+	}
+	year, month, day := date.Date()
+	count := 0
+	if s.n > 0 {
+		for d := 1; d <= day; d++ {
+			if s.calendar.IsBusinessDay(time.Date(year, month, d, 0, 0, 0, 0, date.Location())) {
+				count++
+			}
+		}
+		return count == s.n
+	}
+	for d := dayCountInCurrentMonth(date); d >= day; d-- {
+		if s.calendar.IsBusinessDay(time.Date(year, month, d, 0, 0, 0, 0, date.Location())) {
+			count++
+		}
+	}
+	return count == -s.n
+}
@@ -0,0 +1,104 @@
+package job
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OnHolidayCalendarIsBusinessDay_ShouldReturnFalseForWeekendAndHolidays(t *testing.T) {
+	calendar := Holidays(time.Date(2023, time.February, 23, 0, 0, 0, 0, time.UTC))
+	assert.True(t, calendar.IsBusinessDay(time.Date(2023, time.February, 22, 10, 0, 0, 0, time.Local)))
+	assert.False(t, calendar.IsBusinessDay(time.Date(2023, time.February, 23, 10, 0, 0, 0, time.Local)))
+	assert.False(t, calendar.IsBusinessDay(time.Date(2023, time.February, 25, 10, 0, 0, 0, time.Local)))
+}
+
+func Test_OnLoadHolidaysCSV_ShouldReturnCalendarWithHolidaysFromFirstColumn(t *testing.T) {
+	calendar, err := LoadHolidaysCSV(strings.NewReader("date,name\n2023-02-23,Defender of the Fatherland Day\n2023-03-08,International Women's Day\n"))
+	assert.NoError(t, err)
+	assert.False(t, calendar.IsBusinessDay(time.Date(2023, time.February, 23, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, calendar.IsBusinessDay(time.Date(2023, time.March, 8, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, calendar.IsBusinessDay(time.Date(2023, time.March, 9, 0, 0, 0, 0, time.UTC)))
+}
+
+func Test_OnLoadHolidaysCSVWithInvalidDate_ShouldReturnError(t *testing.T) {
+	_, err := LoadHolidaysCSV(strings.NewReader("2023-02-23\n23.02.2023\n"))
+	assert.Error(t, err)
+}
+
+func Test_OnLoadHolidaysICal_ShouldReturnCalendarWithEveryDayOfEvents(t *testing.T) {
+	calendar, err := LoadHolidaysICal(strings.NewReader("BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20230101\r\nDTEND;VALUE=DATE:20230103\r\nSUMMARY:New Year\r\n holidays\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20230223\r\nSUMMARY:Defender of the Fatherland Day\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"))
+	assert.NoError(t, err)
+	assert.False(t, calendar.IsBusinessDay(time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, calendar.IsBusinessDay(time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, calendar.IsBusinessDay(time.Date(2023, time.February, 23, 0, 0, 0, 0, time.UTC)))
+}
+
+func Test_OnBusinessDaysStrategyTick_ShouldSkipTickTimesAtNonBusinessDays(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 22, 11, 39, 2, 0, time.Local)
+	strategy := BusinessDays(Holidays(time.Date(2023, time.February, 24, 0, 0, 0, 0, time.UTC)), Daily(10, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 23, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 27, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnAdjustStrategyTickWithFollowingAdjustment_ShouldMoveTickTimeToNextBusinessDay(t *testing.T) {
+	lastTickTime := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.Local)
+	strategy := Adjust(Holidays(), Following, Monthly(-1, 10, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.January, 31, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(time.Date(2023, time.April, 1, 0, 0, 0, 0, time.Local))
+	assert.Equal(t, time.Date(2023, time.May, 1, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnAdjustStrategyTickWithPrecedingAdjustment_ShouldMoveTickTimeToPreviousBusinessDayOnlyOnce(t *testing.T) {
+	lastTickTime := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.Local)
+	strategy := Adjust(Holidays(), Preceding, Monthly(15, 10, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.January, 13, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 15, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnAdjustStrategyTickWithModifiedFollowingAdjustment_ShouldNotMoveTickTimeToNextMonth(t *testing.T) {
+	lastTickTime := time.Date(2023, time.April, 1, 0, 0, 0, 0, time.Local)
+	strategy := Adjust(Holidays(), ModifiedFollowing, Monthly(-1, 10, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.April, 28, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnAdjustStrategyTickOverWeekend_ShouldReturnCollapsedTickTimeOnlyOnce(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 10, 0, 0, 0, time.Local)
+	strategy := Adjust(Holidays(), Following, Daily(10, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 20, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 21, 10, 0, 0, 0, time.Local), nextTickTime)
+
+	lastTickTime = time.Date(2023, time.April, 1, 0, 0, 0, 0, time.Local)
+	strategy = Adjust(Holidays(), Following, MonthlyEach([]int{15, 16}, []int{10}, []int{0}, []int{0}))
+	nextTickTime = strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.April, 17, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.May, 15, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnNthBusinessDayStrategyTick_ShouldReturnNthBusinessDayOfTheMonth(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 22, 11, 39, 2, 0, time.Local)
+	strategy := NthBusinessDay(Holidays(time.Date(2023, time.March, 2, 0, 0, 0, 0, time.UTC)), 5, 10, 0, 0)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 8, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnNthBusinessDayStrategyTickWithNegativeNumber_ShouldCountBusinessDaysFromTheEndOfTheMonth(t *testing.T) {
+	lastTickTime := time.Date(2023, time.April, 1, 11, 39, 2, 0, time.Local)
+	strategy := NthBusinessDay(Holidays(), -1, 10, 0, 0)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.April, 28, 10, 0, 0, 0, time.Local), nextTickTime)
+}
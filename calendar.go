@@ -0,0 +1,162 @@
+package job
+
+import (
+	"sort"
+	"time"
+)
+
+// GapPolicy defines how calendar strategies handle wall clock time,
+// that does not exist because of the daylight saving time transition.
+type GapPolicy int
+
+const (
+	// GapShift moves tick time forward by the length of the gap.
+	GapShift GapPolicy = iota
+	// GapSkip skips tick.
+	GapSkip
+)
+
+// OverlapPolicy defines how calendar strategies handle wall clock time,
+// that occurs twice because of the daylight saving time transition.
+type OverlapPolicy int
+
+const (
+	// OverlapFirst ticks at the first occurrence of the time.
+	OverlapFirst OverlapPolicy = iota
+	// OverlapSecond ticks at the second occurrence of the time.
+	OverlapSecond
+	// OverlapBoth ticks at both occurrences of the time.
+	OverlapBoth
+)
+
+type dstPolicy struct {
+	gap     GapPolicy
+	overlap OverlapPolicy
+}
+
+type wallClock struct {
+	hour   int
+	minute int
+	second int
+}
+
+func clock(hour int, minute int, second int) (c wallClock) {
+	return wallClock{
+		hour:   mod(hour, 24),
+		minute: mod(minute, 60),
+		second: mod(second, 60),
+	}
+}
+
+func (c wallClock) sinceMidnight() (d time.Duration) {
+	return time.Duration(c.hour)*time.Hour + time.Duration(c.minute)*time.Minute + time.Duration(c.second)*time.Second
+}
+
+func clocks(hours []int, minutes []int, seconds []int) (c []wallClock) {
+	c = make([]wallClock, 0, len(hours)*len(minutes)*len(seconds))
+	for _, hour := range hours {
+		for _, minute := range minutes {
+			for _, second := range seconds {
+				c = append(c, clock(hour, minute, second))
+			}
+		}
+	}
+	sort.Slice(c, func(i int, j int) (less bool) {
+		return c[i].sinceMidnight() < c[j].sinceMidnight()
+	})
+	return c
+}
+
+func mod(a int, b int) (c int) {
+	c = a % b
+	if c < 0 {
+		c += b
+	}
+	return c
+}
+
+const calendarSearchDays = 8 * 366
+
+// nextCalendarTime returns the nearest time after dt,
+// which date satisfies match function and wall clock is one of the clocks.
+// Dates are passed to match function as midnight in UTC, clocks must be sorted.
+func nextCalendarTime(dt time.Time, match func(date time.Time) (ok bool), clocks []wallClock, policy dstPolicy) (ndt time.Time) {
+	return nextCalendarTimeWithin(dt, calendarSearchDays, match, clocks, policy)
+}
+
+// nextCalendarTimeWithin works like nextCalendarTime, but searches the specified number of days.
+func nextCalendarTimeWithin(dt time.Time, days int, match func(date time.Time) (ok bool), clocks []wallClock, policy dstPolicy) (ndt time.Time) {
+	location := dt.Location()
+	year, month, day := dt.Date()
+	hour, minute, second := dt.Clock()
+	// wall clock, that is not later than wall clock of dt moved back by the transition, can't be after dt
+	since := time.Date(year, month, day, hour, minute, second, 0, time.UTC).Add(-transitionShift(dt))
+	var until time.Time
+	// wall clock of the previous day can be shifted forward to the current day
+	date := time.Date(year, month, day-1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < days; i++ {
+		if match(date) {
+			first := sort.Search(len(clocks), func(i int) (ok bool) {
+				return date.Add(clocks[i].sinceMidnight()).After(since)
+			})
+			for _, c := range clocks[first:] {
+				wall := date.Add(c.sinceMidnight())
+				if !until.IsZero() && !wall.Before(until) {
+					break
+				}
+				for _, candidate := range resolveWallClock(date, c, location, policy) {
+					if candidate.After(dt) && (ndt.IsZero() || candidate.Before(ndt)) {
+						ndt = candidate
+						// later wall clock can be earlier only within the transition
+						until = wall.Add(transitionShift(candidate))
+					}
+				}
+			}
+			if !ndt.IsZero() {
+				return ndt
+			}
+		}
+		date = date.AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
+
+const transitionWindow = 24 * time.Hour
+
+func resolveWallClock(date time.Time, c wallClock, location *time.Location, policy dstPolicy) (instants []time.Time) {
+	wall := time.Date(date.Year(), date.Month(), date.Day(), c.hour, c.minute, c.second, 0, time.UTC)
+	_, offsetBefore := wall.Add(-transitionWindow).In(location).Zone()
+	_, offsetAfter := wall.Add(transitionWindow).In(location).Zone()
+	for _, offset := range []int{offsetBefore, offsetAfter} {
+		instant := wall.Add(-time.Duration(offset) * time.Second).In(location)
+		if !sameWallClock(instant, wall) || len(instants) != 0 && instants[0].Equal(instant) {
+			continue
+		}
+		instants = append(instants, instant)
+	}
+	switch {
+	case len(instants) == 0 && policy.gap == GapShift:
+		return []time.Time{wall.Add(-time.Duration(offsetBefore) * time.Second).In(location)}
+	case len(instants) == 2 && policy.overlap == OverlapFirst:
+		return instants[:1]
+	case len(instants) == 2 && policy.overlap == OverlapSecond:
+		return instants[1:]
+	default:
+		return instants
+	}
+}
+
+// transitionShift returns offset change of the daylight saving time transition around dt.
+func transitionShift(dt time.Time) (shift time.Duration) {
+	_, offsetBefore := dt.Add(-transitionWindow).Zone()
+	_, offsetAfter := dt.Add(transitionWindow).Zone()
+	return time.Duration(max(offsetBefore-offsetAfter, offsetAfter-offsetBefore)) * time.Second
+}
+
+func sameWallClock(a time.Time, b time.Time) (ok bool) {
+	aYear, aMonth, aDay := a.Date()
+	bYear, bMonth, bDay := b.Date()
+	aHour, aMinute, aSecond := a.Clock()
+	bHour, bMinute, bSecond := b.Clock()
+	return aYear == bYear && aMonth == bMonth && aDay == bDay && aHour == bHour && aMinute == bMinute && aSecond == bSecond
+}
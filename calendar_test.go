@@ -0,0 +1,127 @@
+package job
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OnCalendarStrategyTickAroundDaylightSavingTimeTransition_ShouldReturnTickTimeAccordingToWallClockAndPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		location     string
+		strategy     Strategy
+		lastTickTime string
+		expected     []string
+	}{
+		{
+			name:         "daily keeps wall clock over spring forward",
+			location:     "Europe/Berlin",
+			strategy:     Daily(9, 0, 0),
+			lastTickTime: "2023-03-25 09:00:00",
+			expected:     []string{"2023-03-26T09:00:00+02:00", "2023-03-27T09:00:00+02:00"},
+		},
+		{
+			name:         "daily keeps wall clock over fall back",
+			location:     "Europe/Berlin",
+			strategy:     Daily(9, 0, 0),
+			lastTickTime: "2023-10-28 09:00:00",
+			expected:     []string{"2023-10-29T09:00:00+01:00"},
+		},
+		{
+			name:         "daily shifts nonexistent time forward",
+			location:     "America/New_York",
+			strategy:     Daily(2, 30, 0),
+			lastTickTime: "2023-03-11 02:30:00",
+			expected:     []string{"2023-03-12T03:30:00-04:00", "2023-03-13T02:30:00-04:00"},
+		},
+		{
+			name:         "daily skips nonexistent time",
+			location:     "America/New_York",
+			strategy:     Daily(2, 30, 0).WithGap(GapSkip),
+			lastTickTime: "2023-03-11 02:30:00",
+			expected:     []string{"2023-03-13T02:30:00-04:00"},
+		},
+		{
+			name:         "daily ticks at first occurrence of ambiguous time",
+			location:     "America/New_York",
+			strategy:     Daily(1, 30, 0),
+			lastTickTime: "2023-11-04 01:30:00",
+			expected:     []string{"2023-11-05T01:30:00-04:00", "2023-11-06T01:30:00-05:00"},
+		},
+		{
+			name:         "daily ticks at second occurrence of ambiguous time",
+			location:     "America/New_York",
+			strategy:     Daily(1, 30, 0).WithOverlap(OverlapSecond),
+			lastTickTime: "2023-11-04 01:30:00",
+			expected:     []string{"2023-11-05T01:30:00-05:00", "2023-11-06T01:30:00-05:00"},
+		},
+		{
+			name:         "daily ticks at both occurrences of ambiguous time",
+			location:     "America/New_York",
+			strategy:     Daily(1, 30, 0).WithOverlap(OverlapBoth),
+			lastTickTime: "2023-11-04 01:30:00",
+			expected:     []string{"2023-11-05T01:30:00-04:00", "2023-11-05T01:30:00-05:00", "2023-11-06T01:30:00-05:00"},
+		},
+		{
+			name:         "hourly ticks every hour over fall back",
+			location:     "America/New_York",
+			strategy:     Hourly(30, 0),
+			lastTickTime: "2023-11-05 00:30:00",
+			expected:     []string{"2023-11-05T01:30:00-04:00", "2023-11-05T01:30:00-05:00", "2023-11-05T02:30:00-05:00"},
+		},
+		{
+			name:         "hourly does not tick twice over spring forward",
+			location:     "America/New_York",
+			strategy:     Hourly(30, 0),
+			lastTickTime: "2023-03-12 01:30:00",
+			expected:     []string{"2023-03-12T03:30:00-04:00", "2023-03-12T04:30:00-04:00"},
+		},
+		{
+			name:         "hourly ticks once at repeated hour",
+			location:     "Europe/Berlin",
+			strategy:     Hourly(0, 0).WithGap(GapSkip).WithOverlap(OverlapFirst),
+			lastTickTime: "2023-10-29 01:00:00",
+			expected:     []string{"2023-10-29T02:00:00+02:00", "2023-10-29T03:00:00+01:00"},
+		},
+		{
+			name:         "weekly keeps wall clock over fall back",
+			location:     "Australia/Sydney",
+			strategy:     Weekly(time.Sunday, 10, 0, 0),
+			lastTickTime: "2023-03-26 10:00:00",
+			expected:     []string{"2023-04-02T10:00:00+10:00"},
+		},
+		{
+			name:         "monthly shifts nonexistent time forward",
+			location:     "Australia/Sydney",
+			strategy:     Monthly(1, 2, 15, 0),
+			lastTickTime: "2023-09-01 02:15:00",
+			expected:     []string{"2023-10-01T03:15:00+11:00", "2023-11-01T02:15:00+11:00"},
+		},
+		{
+			name:         "yearly skips nonexistent time",
+			location:     "Australia/Sydney",
+			strategy:     Yearly(time.October, 1, 2, 15, 0).WithGap(GapSkip),
+			lastTickTime: "2022-10-03 00:00:00",
+			expected:     []string{"2024-10-01T02:15:00+10:00"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			location, err := time.LoadLocation(test.location)
+			require.NoError(t, err)
+			lastTickTime, err := time.ParseInLocation("2006-01-02 15:04:05", test.lastTickTime, location)
+			require.NoError(t, err)
+			for _, value := range test.expected {
+				expected, err := time.Parse(time.RFC3339, value)
+				require.NoError(t, err)
+				nextTickTime := test.strategy.Tick(lastTickTime)
+				assert.True(t, expected.Equal(nextTickTime), "expected %s, got %s", expected, nextTickTime)
+				lastTickTime = nextTickTime
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package job
+
+import (
+	"time"
+)
+
+const combinatorSearchLimit = 10000
+
+// Intersect ticks at the nearest time, when all strategies tick simultaneously.
+// Strategies must be stateless and calculate tick time from the last tick time, like calendar ones.
+// Job finishes, if such time is not found within bounded search.
+func Intersect(strategy Strategy, strategies ...Strategy) IntersectStrategy {
+	return IntersectStrategy{
+		strategies: append([]Strategy{strategy}, strategies...),
+	}
+}
+
+var _ Strategy = (*IntersectStrategy)(nil)
+
+type IntersectStrategy struct {
+	strategies []Strategy
+}
+
+func (s IntersectStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	cursor := lastTickTime
+	for i := 0; i < combinatorSearchLimit; i++ {
+		var latest time.Time
+		agreed := true
+		for _, strategy := range s.strategies {
+			tickTime := strategy.Tick(cursor)
+			if tickTime.IsZero() {
+				return time.Time{}
+			}
+			if !latest.IsZero() && !tickTime.Equal(latest) {
+				agreed = false
+			}
+			if tickTime.After(latest) {
+				latest = tickTime
+			}
+		}
+		if agreed {
+			return latest
+		}
+		// next search starts from the latest tick time inclusively
+		cursor = latest.Add(-time.Nanosecond)
+	}
+	return time.Time{}
+}
+
+// Except skips ticks of the base strategy, when any of excluded strategies ticks at the same time.
+// Excluded strategies must be stateless, like calendar ones.
+func Except(base Strategy, excluded ...Strategy) ExceptStrategy {
+	return ExceptStrategy{
+		base:     base,
+		excluded: excluded,
+	}
+}
+
+// ExceptFunc skips ticks of the base strategy, which satisfy predicate.
+func ExceptFunc(base Strategy, predicate func(tickTime time.Time) (excluded bool)) ExceptStrategy {
+	return ExceptStrategy{
+		base:      base,
+		predicate: predicate,
+	}
+}
+
+var _ ResultStrategy = (*ExceptStrategy)(nil)
+
+type ExceptStrategy struct {
+	base      Strategy
+	excluded  []Strategy
+	predicate func(tickTime time.Time) (excluded bool)
+}
+
+func (s ExceptStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return s.skip(s.base.Tick(lastTickTime))
+}
+
+func (s ExceptStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	return s.skip(tickResult(s.base, lastTickTime, err))
+}
+
+func (s ExceptStrategy) skip(tickTime time.Time) (nextTickTime time.Time) {
+	for i := 0; i < combinatorSearchLimit; i++ {
+		if tickTime.IsZero() || !s.isExcluded(tickTime) {
+			return tickTime
+		}
+		tickTime = s.base.Tick(tickTime)
+	}
+	return time.Time{}
+}
+
+func (s ExceptStrategy) isExcluded(tickTime time.Time) (ok bool) {
+	if s.predicate != nil && s.predicate(tickTime) {
+		return true
+	}
+	for _, strategy := range s.excluded {
+		if ticksAt(strategy, tickTime) {
+			return true
+		}
+	}
+	return false
+}
+
+func ticksAt(strategy Strategy, tickTime time.Time) (ok bool) {
+	return strategy.Tick(tickTime.Add(-time.Nanosecond)).Equal(tickTime)
+}
@@ -0,0 +1,38 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OnIntersectStrategyTick_ShouldReturnNearestTickTimeOfAllUnderlyingStrategies(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := Intersect(Weekly(time.Monday, 10, 0, 0), Monthly(1, 10, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.May, 1, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnIntersectStrategyTickWithoutCommonTickTime_ShouldReturnZeroTickTime(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := Intersect(Daily(10, 0, 0), Daily(11, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.True(t, nextTickTime.IsZero())
+}
+
+func Test_OnExceptStrategyTick_ShouldSkipTickTimesOfExcludedStrategies(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 28, 11, 39, 2, 0, time.Local)
+	strategy := Except(Daily(10, 0, 0), Monthly(1, 10, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 2, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnExceptFuncStrategyTick_ShouldSkipTickTimesSatisfyingPredicate(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := ExceptFunc(Daily(10, 0, 0), func(tickTime time.Time) (excluded bool) {
+		return tickTime.Weekday() == time.Saturday || tickTime.Weekday() == time.Sunday
+	})
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 20, 10, 0, 0, 0, time.Local), nextTickTime)
+}
@@ -0,0 +1,473 @@
+package job
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron parses crontab expression with five fields (minute, hour, day of month, month, day of week)
+// or six fields, when leading seconds field is specified.
+// Day fields also accept Quartz operators: "?", "L", "W" and "#".
+// Descriptors "@yearly", "@monthly", "@weekly", "@daily", "@hourly", "@reboot" and "@every <duration>"
+// are mapped to the corresponding strategies.
+func Cron(expr string) (strategy Strategy, err error) {
+	if strings.HasPrefix(expr, "@") {
+		return parseCronDescriptor(expr)
+	}
+	fields := strings.Fields(expr)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("cron %q: expected 5 or 6 fields, got %d", expr, len(fields))
+	}
+	s, err := parseCron(expr, append(fields, "*"), cronWeekdays)
+	if err == nil {
+		s.dialect = "cron"
+		err = s.validate()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cron %q: %w", expr, err)
+	}
+	return s, nil
+}
+
+func parseCronDescriptor(expr string) (strategy Strategy, err error) {
+	descriptor, value, _ := strings.Cut(strings.TrimSpace(expr), " ")
+	switch descriptor {
+	case "@yearly", "@annually":
+		return Yearly(time.January, 1, 0, 0, 0), nil
+	case "@monthly":
+		return Monthly(1, 0, 0, 0), nil
+	case "@weekly":
+		return Weekly(time.Sunday, 0, 0, 0), nil
+	case "@daily", "@midnight":
+		return Daily(0, 0, 0), nil
+	case "@hourly":
+		return Hourly(0, 0), nil
+	case "@reboot":
+		return OnStart(), nil
+	case "@every":
+		interval, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("cron %q: %w", expr, err)
+		}
+		if interval <= 0 {
+			return nil, fmt.Errorf("cron %q: interval must be positive", expr)
+		}
+		return Interval(interval), nil
+	default:
+		return nil, fmt.Errorf("cron %q: unknown descriptor %q", expr, descriptor)
+	}
+}
+
+// Quartz parses Quartz scheduler expression with six or seven fields
+// (second, minute, hour, day of month, month, day of week, optional year).
+// Unlike crontab, days of week are numbered from 1 (SUN) to 7 (SAT).
+func Quartz(expr string) (strategy Strategy, err error) {
+	fields := strings.Fields(expr)
+	switch len(fields) {
+	case 6:
+		fields = append(fields, "*")
+	case 7:
+	default:
+		return nil, fmt.Errorf("quartz %q: expected 6 or 7 fields, got %d", expr, len(fields))
+	}
+	s, err := parseCron(expr, fields, quartzWeekdays)
+	if err == nil {
+		s.dialect = "quartz"
+		err = s.validate()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("quartz %q: %w", expr, err)
+	}
+	return s, nil
+}
+
+func parseCron(expr string, fields []string, weekdays cronRange) (s CronStrategy, err error) {
+	s.expr = expr
+	if s.seconds, _, err = parseCronField(fields[0], cronSeconds); err != nil {
+		return s, err
+	}
+	if s.minutes, _, err = parseCronField(fields[1], cronMinutes); err != nil {
+		return s, err
+	}
+	if s.hours, _, err = parseCronField(fields[2], cronHours); err != nil {
+		return s, err
+	}
+	if err = s.parseDays(fields[3]); err != nil {
+		return s, err
+	}
+	if s.months, _, err = parseCronField(fields[4], cronMonths); err != nil {
+		return s, err
+	}
+	if err = s.parseWeekdays(fields[5], weekdays); err != nil {
+		return s, err
+	}
+	if err = s.parseYears(fields[6]); err != nil {
+		return s, err
+	}
+	s.clocks = clocks(s.hours.values(), s.minutes.values(), s.seconds.values())
+	return s, nil
+}
+
+func (s *CronStrategy) parseDays(field string) (err error) {
+	for _, item := range strings.Split(field, ",") {
+		switch {
+		case item == "?":
+			s.days |= cronAll(cronDays)
+			s.anyDay = true
+		case item == "LW":
+			s.lastWorkday = true
+		case item == "L":
+			s.lastDays |= 1
+		case strings.HasPrefix(item, "L-"):
+			offset, err := strconv.Atoi(strings.TrimPrefix(item, "L-"))
+			if err != nil || offset < 0 || offset >= cronDays.max {
+				return fmt.Errorf("%s %q has invalid offset from the last day", cronDays.name, item)
+			}
+			s.lastDays |= 1 << uint(offset)
+		case strings.HasSuffix(item, "W"):
+			day, err := parseCronValue(strings.TrimSuffix(item, "W"), cronDays)
+			if err != nil {
+				return err
+			}
+			s.nearestDays |= 1 << uint(day)
+		default:
+			bits, wildcard, err := parseCronItem(item, cronDays)
+			if err != nil {
+				return err
+			}
+			s.days |= bits
+			s.anyDay = s.anyDay || wildcard
+		}
+	}
+	return nil
+}
+
+func (s *CronStrategy) parseWeekdays(field string, r cronRange) (err error) {
+	for _, item := range strings.Split(field, ",") {
+		switch {
+		case item == "?":
+			s.weekdays |= 1<<7 - 1
+			s.anyWeekday = true
+		case item == "L":
+			s.weekdays |= 1 << time.Saturday
+		case strings.HasSuffix(item, "L"):
+			weekday, err := parseCronWeekday(strings.TrimSuffix(item, "L"), r)
+			if err != nil {
+				return err
+			}
+			s.lastWeekdays |= 1 << uint(weekday)
+		case strings.Contains(item, "#"):
+			value, nth, _ := strings.Cut(item, "#")
+			weekday, err := parseCronWeekday(value, r)
+			if err != nil {
+				return err
+			}
+			n, err := strconv.Atoi(nth)
+			if err != nil || n < 1 || n > 5 {
+				return fmt.Errorf("%s %q has invalid week number", r.name, item)
+			}
+			s.nthWeekdays[weekday] |= 1 << uint(n)
+		default:
+			bits, wildcard, err := parseCronItem(item, r)
+			if err != nil {
+				return err
+			}
+			for value := r.min; value <= r.max; value++ {
+				if bits.has(value) {
+					s.weekdays |= 1 << uint(r.weekday(value))
+				}
+			}
+			s.anyWeekday = s.anyWeekday || wildcard
+		}
+	}
+	return nil
+}
+
+func parseCronWeekday(value string, r cronRange) (weekday time.Weekday, err error) {
+	number, err := parseCronValue(value, r)
+	if err != nil {
+		return 0, err
+	}
+	return r.weekday(number), nil
+}
+
+func (s *CronStrategy) parseYears(field string) (err error) {
+	if field == "*" {
+		return nil
+	}
+	s.years = map[int]bool{}
+	for _, item := range strings.Split(field, ",") {
+		rng, step, hasStep := strings.Cut(item, "/")
+		from, to := cronYears.min, cronYears.max
+		if rng != "*" {
+			lo, hi, isRange := strings.Cut(rng, "-")
+			if from, err = parseCronValue(lo, cronYears); err != nil {
+				return err
+			}
+			to = from
+			if isRange {
+				if to, err = parseCronValue(hi, cronYears); err != nil {
+					return err
+				}
+				if from > to {
+					return fmt.Errorf("%s range %q is reversed", cronYears.name, item)
+				}
+			} else if hasStep {
+				to = cronYears.max
+			}
+		}
+		increment := 1
+		if hasStep {
+			increment, err = strconv.Atoi(step)
+			if err != nil || increment <= 0 {
+				return fmt.Errorf("%s step %q is not a positive number", cronYears.name, step)
+			}
+		}
+		for year := from; year <= to; year += increment {
+			s.years[year] = true
+			s.lastYear = max(s.lastYear, year)
+		}
+	}
+	return nil
+}
+
+var _ Strategy = (*CronStrategy)(nil)
+
+type CronStrategy struct {
+	dialect      string
+	expr         string
+	seconds      cronBits
+	minutes      cronBits
+	hours        cronBits
+	days         cronBits
+	lastDays     cronBits
+	nearestDays  cronBits
+	lastWorkday  bool
+	months       cronBits
+	weekdays     cronBits
+	lastWeekdays cronBits
+	nthWeekdays  [7]cronBits
+	years        map[int]bool
+	lastYear     int
+	anyDay       bool
+	anyWeekday   bool
+	location     *time.Location
+	clocks       []wallClock
+}
+
+const cronSearchYears = 30
+
+func (s CronStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	location := lastTickTime.Location()
+	if s.location != nil {
+		location = s.location
+	}
+	dt := lastTickTime.In(location)
+	days := cronSearchYears * 366
+	if s.years != nil {
+		// search starts from the previous day
+		end := time.Date(s.lastYear+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		days = int(end.Sub(time.Date(dt.Year(), dt.Month(), dt.Day()-1, 0, 0, 0, 0, time.UTC)).Hours()) / 24
+	}
+	nextTickTime = nextCalendarTimeWithin(dt, days, s.matchDate, s.clocks, s.policy())
+	if nextTickTime.IsZero() {
+		return time.Time{}
+	}
+	return nextTickTime.In(lastTickTime.Location())
+}
+
+const allHours cronBits = 1<<24 - 1
+
+// policy follows calendar strategies: time, that occurs twice, is used twice only if every hour matches, like in Hourly.
+func (s CronStrategy) policy() (policy dstPolicy) {
+	policy = dstPolicy{gap: GapShift, overlap: OverlapFirst}
+	if s.hours&allHours == allHours {
+		policy.overlap = OverlapBoth
+	}
+	return policy
+}
+
+func (s CronStrategy) matchDate(date time.Time) (ok bool) {
+	if s.years != nil && !s.years[date.Year()] {
+		return false
+	}
+	return s.months.has(int(date.Month())) && s.matchDay(date)
+}
+
+func (s CronStrategy) matchDay(dt time.Time) (ok bool) {
+	day := s.matchDayOfMonth(dt)
+	weekday := s.matchDayOfWeek(dt)
+	if s.anyDay || s.anyWeekday {
+		return day && weekday
+	}
+	return day || weekday
+}
+
+func (s CronStrategy) matchDayOfMonth(dt time.Time) (ok bool) {
+	day := dt.Day()
+	lastDay := dayCountInCurrentMonth(dt)
+	if s.days.has(day) || s.lastDays.has(lastDay-day) {
+		return true
+	}
+	if s.lastWorkday && day == nearestWorkday(dt, lastDay) {
+		return true
+	}
+	for n := 1; n <= lastDay; n++ {
+		if s.nearestDays.has(n) && day == nearestWorkday(dt, n) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s CronStrategy) matchDayOfWeek(dt time.Time) (ok bool) {
+	day := dt.Day()
+	weekday := dt.Weekday()
+	if s.weekdays.has(int(weekday)) {
+		return true
+	}
+	if s.lastWeekdays.has(int(weekday)) && day+7 > dayCountInCurrentMonth(dt) {
+		return true
+	}
+	return s.nthWeekdays[weekday].has((day-1)/7 + 1)
+}
+
+func nearestWorkday(dt time.Time, day int) (workday int) {
+	lastDay := dayCountInCurrentMonth(dt)
+	switch time.Date(dt.Year(), dt.Month(), day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == lastDay {
+			return day - 2
+		}
+		return day + 1
+	default:
+		return day
+	}
+}
+
+type cronBits uint64
+
+func (b cronBits) values() (values []int) {
+	for value := 0; value < 64; value++ {
+		if b.has(value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func (b cronBits) has(value int) (ok bool) {
+	return b&(1<<uint(value)) != 0
+}
+
+type cronRange struct {
+	name  string
+	min   int
+	max   int
+	shift int
+	names map[string]int
+}
+
+func (r cronRange) weekday(value int) (weekday time.Weekday) {
+	return time.Weekday((value - r.shift) % 7)
+}
+
+var (
+	cronSeconds = cronRange{name: "second", min: 0, max: 59}
+	cronMinutes = cronRange{name: "minute", min: 0, max: 59}
+	cronHours   = cronRange{name: "hour", min: 0, max: 23}
+	cronDays    = cronRange{name: "day of month", min: 1, max: 31}
+	cronMonths  = cronRange{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	cronWeekdays = cronRange{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+	quartzWeekdays = cronRange{name: "day of week", min: 1, max: 7, shift: 1, names: map[string]int{
+		"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+	}}
+	cronYears = cronRange{name: "year", min: 1970, max: 2199}
+)
+
+func cronAll(r cronRange) (bits cronBits) {
+	for value := r.min; value <= r.max; value++ {
+		bits |= 1 << uint(value)
+	}
+	return bits
+}
+
+func parseCronField(field string, r cronRange) (bits cronBits, wildcard bool, err error) {
+	for _, item := range strings.Split(field, ",") {
+		itemBits, itemWildcard, err := parseCronItem(item, r)
+		if err != nil {
+			return 0, false, err
+		}
+		bits |= itemBits
+		wildcard = wildcard || itemWildcard
+	}
+	return bits, wildcard, nil
+}
+
+func parseCronItem(item string, r cronRange) (bits cronBits, wildcard bool, err error) {
+	rng, step, hasStep := strings.Cut(item, "/")
+	from, to := r.min, r.max
+	switch {
+	case rng == "*":
+		wildcard = !hasStep
+	case strings.Contains(rng, "-"):
+		lo, hi, _ := strings.Cut(rng, "-")
+		if from, err = parseCronValue(lo, r); err != nil {
+			return 0, false, err
+		}
+		if to, err = parseCronValue(hi, r); err != nil {
+			return 0, false, err
+		}
+		if from > to {
+			return 0, false, fmt.Errorf("%s range %q is reversed", r.name, item)
+		}
+	default:
+		if from, err = parseCronValue(rng, r); err != nil {
+			return 0, false, err
+		}
+		if !hasStep {
+			to = from
+		}
+	}
+	increment := 1
+	if hasStep {
+		increment, err = strconv.Atoi(step)
+		if err != nil || increment <= 0 {
+			return 0, false, fmt.Errorf("%s step %q is not a positive number", r.name, step)
+		}
+	}
+	for value := from; value <= to; value += increment {
+		bits |= 1 << uint(value)
+	}
+	return bits, wildcard, nil
+}
+
+func parseCronValue(value string, r cronRange) (number int, err error) {
+	if number, ok := r.names[strings.ToUpper(value)]; ok {
+		return number, nil
+	}
+	number, err = strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s %q is not a number", r.name, value)
+	}
+	if number < r.min || number > r.max {
+		return 0, fmt.Errorf("%s %d is out of range [%d, %d]", r.name, number, r.min, r.max)
+	}
+	return number, nil
+}
@@ -0,0 +1,232 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OnCronStrategyTickWithStep_ShouldReturnNearestTickTimeInTheFutureAccordingToExpression(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy, err := Cron("*/15 * * * *")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 17, 11, 45, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCronStrategyTickWhenLastTickEqualTimeInExpression_ShouldReturnNextTickTime(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 10, 30, 0, 0, time.Local)
+	strategy, err := Cron("30 10 * * *")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 18, 10, 30, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCronStrategyTickWithWeekdayNames_ShouldSkipDaysOutOfRange(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy, err := Cron("0 9 * * MON-FRI")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 20, 9, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCronStrategyTickWithMonthList_ShouldReturnTickTimeInNearestMonthOfTheList(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy, err := Cron("0 0 1 jan,jul *")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.July, 1, 0, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCronStrategyTickWithDayOfMonthAndDayOfWeek_ShouldReturnTickTimeMatchingAnyOfThem(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy, err := Cron("0 0 25 * 7")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 19, 0, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCronStrategyTickWithSecondsField_ShouldReturnTickTimeWithSpecifiedSeconds(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy, err := Cron("10-50/20 * * * * *")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 17, 11, 39, 10, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 17, 11, 39, 30, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCronParseWithInvalidExpression_ShouldReturnError(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "5-1 * * * *", "*/0 * * * *", "* * * foo *"} {
+		_, err := Cron(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func Test_OnCronParseWithExpressionThatNeverMatches_ShouldReturnError(t *testing.T) {
+	_, err := Cron("0 0 30 2 *")
+	assert.EqualError(t, err, `cron "0 0 30 2 *": expression never matches`)
+	_, err = Quartz("0 0 10 31 APR ? *")
+	assert.EqualError(t, err, `quartz "0 0 10 31 APR ? *": expression never matches`)
+	_, err = OnCalendar("*-02-30 00:00")
+	assert.EqualError(t, err, `calendar "*-02-30 00:00": expression never matches`)
+}
+
+func Test_OnCronStrategyTickWithLastDayOfMonth_ShouldReturnLastDayOfTheMonth(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy, err := Cron("0 10 L * ?")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 28, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCronStrategyTickWithOffsetFromLastDayOfMonth_ShouldReturnTickTimeOfTheReverseExpression(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy, err := Cron("0 10 L-2 * ?")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 26, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCronStrategyTickWithNearestWeekday_ShouldMoveTickTimeFromWeekendToTheNearestWeekday(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 1, 11, 39, 2, 0, time.Local)
+	strategy, err := Cron("0 10 18W * ?")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 17, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCronStrategyTickWithNearestWeekdayOnFirstSaturday_ShouldNotLeaveTheMonth(t *testing.T) {
+	lastTickTime := time.Date(2023, time.March, 20, 11, 39, 2, 0, time.Local)
+	strategy, err := Cron("0 10 1W * ?")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.April, 3, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCronStrategyTickWithLastWeekdayOfMonth_ShouldReturnLastWorkingDayOfTheMonth(t *testing.T) {
+	lastTickTime := time.Date(2023, time.April, 1, 11, 39, 2, 0, time.Local)
+	strategy, err := Cron("0 10 LW * ?")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.April, 28, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCronStrategyTickWithNthWeekday_ShouldReturnNthWeekdayOfTheMonth(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 1, 11, 39, 2, 0, time.Local)
+	strategy, err := Cron("0 10 ? * TUE#2")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 14, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCronStrategyTickWithLastWeekdayOfMonth_ShouldReturnLastSpecifiedWeekdayOfTheMonth(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 1, 11, 39, 2, 0, time.Local)
+	strategy, err := Cron("0 10 ? * 5L")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 24, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnQuartzStrategyTick_ShouldNumberWeekdaysFromSunday(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 1, 11, 39, 2, 0, time.Local)
+	strategy, err := Quartz("0 0 10 ? * 3#2")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 14, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnQuartzStrategyTickWithYearField_ShouldReturnTickTimeInSpecifiedYears(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 1, 11, 39, 2, 0, time.Local)
+	strategy, err := Quartz("0 0 10 1 JAN ? 2025-2030/2")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2025, time.January, 1, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(time.Date(2029, time.January, 1, 10, 0, 0, 0, time.Local))
+	assert.Equal(t, time.Time{}, nextTickTime)
+}
+
+func Test_OnQuartzParseWithInvalidExpression_ShouldReturnError(t *testing.T) {
+	for _, expr := range []string{"0 0 10 * *", "0 0 10 ? * 0", "0 0 10 ? * 2#6", "0 0 10 L-40 * ?", "0 0 10 ? * * 1900"} {
+		_, err := Quartz(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func Test_OnCronParseWithDescriptor_ShouldReturnCorrespondingStrategy(t *testing.T) {
+	for expr, expected := range map[string]Strategy{
+		"@yearly":      Yearly(time.January, 1, 0, 0, 0),
+		"@monthly":     Monthly(1, 0, 0, 0),
+		"@weekly":      Weekly(time.Sunday, 0, 0, 0),
+		"@daily":       Daily(0, 0, 0),
+		"@hourly":      Hourly(0, 0),
+		"@every 1h30m": Interval(90 * time.Minute),
+		"@reboot":      OnStart(),
+	} {
+		strategy, err := Cron(expr)
+		assert.NoError(t, err, expr)
+		assert.Equal(t, expected, strategy, expr)
+	}
+}
+
+func Test_OnCronStrategyTickWithRebootDescriptor_ShouldReturnTickTimeOnlyOnce(t *testing.T) {
+	strategy, err := Cron("@reboot")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(time.Now())
+	assert.InDelta(t, time.Now().UnixNano(), nextTickTime.UnixNano(), float64(10*time.Millisecond))
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.True(t, nextTickTime.IsZero())
+}
+
+func Test_OnCronParseWithInvalidDescriptor_ShouldReturnError(t *testing.T) {
+	for _, expr := range []string{"@fortnightly", "@every", "@every 1 hour", "@every -1s"} {
+		_, err := Cron(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func Test_OnCronStrategyTickAroundDaylightSavingTimeTransition_ShouldReturnTickTimeAccordingToWallClock(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	tests := []struct {
+		expr         string
+		lastTickTime string
+		expected     []string
+	}{
+		{
+			expr:         "0 0 * * * *",
+			lastTickTime: "2023-11-05 00:00:00",
+			expected:     []string{"2023-11-05T01:00:00-04:00", "2023-11-05T01:00:00-05:00", "2023-11-05T02:00:00-05:00"},
+		},
+		{
+			expr:         "0 30 1 * * *",
+			lastTickTime: "2023-11-04 01:30:00",
+			expected:     []string{"2023-11-05T01:30:00-04:00", "2023-11-06T01:30:00-05:00"},
+		},
+		{
+			expr:         "0 30 2 * * *",
+			lastTickTime: "2023-03-11 02:30:00",
+			expected:     []string{"2023-03-12T03:30:00-04:00", "2023-03-13T02:30:00-04:00"},
+		},
+		{
+			expr:         "*/30 * * * *",
+			lastTickTime: "2023-03-12 01:30:00",
+			expected:     []string{"2023-03-12T03:00:00-04:00", "2023-03-12T03:30:00-04:00"},
+		},
+	}
+	for _, test := range tests {
+		strategy, err := Cron(test.expr)
+		require.NoError(t, err, test.expr)
+		lastTickTime, err := time.ParseInLocation("2006-01-02 15:04:05", test.lastTickTime, location)
+		require.NoError(t, err)
+		for _, value := range test.expected {
+			expected, err := time.Parse(time.RFC3339, value)
+			require.NoError(t, err)
+			nextTickTime := strategy.Tick(lastTickTime)
+			assert.True(t, expected.Equal(nextTickTime), "%s: expected %s, got %s", test.expr, expected, nextTickTime)
+			lastTickTime = nextTickTime
+		}
+	}
+}
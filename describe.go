@@ -0,0 +1,543 @@
+package job
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Describer is a strategy, that explains its schedule in human-readable form.
+type Describer interface {
+	Describe() (description string)
+}
+
+var _ Describer = (*Schedule)(nil)
+
+func (s Schedule) Describe() (description string) {
+	return describe(s.Strategy)
+}
+
+func describe(strategy Strategy) (description string) {
+	if d, ok := strategy.(Describer); ok {
+		return d.Describe()
+	}
+	return "custom schedule"
+}
+
+func describeTime(t time.Time) (description string) {
+	hour, minute, second := t.Clock()
+	if hour == 0 && minute == 0 && second == 0 && t.Nanosecond() == 0 {
+		return t.Format("2006-01-02")
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
+
+func describeClock(hour int, minute int, second int) (description string) {
+	return fmt.Sprintf("%02d:%02d:%02d", hour, minute, second)
+}
+
+func describeClocks(hours []int, minutes []int, seconds []int) (description string) {
+	var items []string
+	for _, hour := range hours {
+		for _, minute := range minutes {
+			for _, second := range seconds {
+				items = append(items, describeClock(hour, minute, second))
+			}
+		}
+	}
+	return joinWords(items)
+}
+
+func describeOffset(offset time.Duration) (description string) {
+	return describeClock(int(offset/time.Hour), int(offset%time.Hour/time.Minute), int(offset%time.Minute/time.Second))
+}
+
+func describeWeekdays(weekdays []time.Weekday) (description string) {
+	items := make([]string, 0, len(weekdays))
+	for _, weekday := range weekdays {
+		items = append(items, weekday.String())
+	}
+	return joinWords(items)
+}
+
+func describeDays(days []int) (description string) {
+	items := make([]string, 0, len(days))
+	for _, day := range days {
+		items = append(items, ordinal(day))
+	}
+	return joinWords(items)
+}
+
+func describeTimes(times []time.Time) (description string) {
+	items := make([]string, 0, len(times))
+	for _, t := range times {
+		items = append(items, describeTime(t))
+	}
+	return joinWords(items)
+}
+
+// describeValues enumerates values in ascending order,
+// runs of three and more consecutive values are described as ranges: "1, 5 through 9 and 12".
+func describeValues(values []int, name func(value int) (description string)) (description string) {
+	values = append([]int(nil), values...)
+	sort.Ints(values)
+	var items []string
+	for i := 0; i < len(values); i++ {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		if j-i >= 2 {
+			items = append(items, name(values[i])+" through "+name(values[j]))
+			i = j
+			continue
+		}
+		items = append(items, name(values[i]))
+	}
+	return joinWords(items)
+}
+
+// describeUnits returns "minute 5" or "minutes 0, 15, 30 and 45".
+func describeUnits(unit string, values []int, name func(value int) (description string)) (description string) {
+	if len(values) != 1 {
+		unit += "s"
+	}
+	return unit + " " + describeValues(values, name)
+}
+
+func monthName(value int) (description string) {
+	return time.Month(value).String()
+}
+
+func weekdayName(value int) (description string) {
+	return time.Weekday(value).String()
+}
+
+func describeAll(strategies []Strategy) (description string) {
+	items := make([]string, 0, len(strategies))
+	for _, strategy := range strategies {
+		items = append(items, describe(strategy))
+	}
+	return joinWords(items)
+}
+
+// ordinal returns "1st", "2nd", "3rd" and so on, negative numbers are counted from the end: "last", "2nd last".
+func ordinal(n int) (description string) {
+	if n == -1 {
+		return "last"
+	}
+	if n < 0 {
+		return ordinal(-n) + " last"
+	}
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+// plural returns "1 minute", "2 minutes" and so on.
+func plural(n int, unit string) (description string) {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+// joinWords joins items as english enumeration: "a", "a and b", "a, b and c".
+func joinWords(items []string) (description string) {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
+
+func (s FunctionStrategy) Describe() (description string) {
+	return "custom schedule"
+}
+
+func (s *DelayStrategy) Describe() (description string) {
+	return fmt.Sprintf("first in %s then %s", s.delay, describe(s.strategy))
+}
+
+func (s *AtStrategy) Describe() (description string) {
+	return fmt.Sprintf("first at %s then %s", describeTime(s.time), describe(s.strategy))
+}
+
+func (s LocationStrategy) Describe() (description string) {
+	return fmt.Sprintf("%s in %s", describe(s.strategy), s.location)
+}
+
+func (s IntervalStrategy) Describe() (description string) {
+	return fmt.Sprintf("every %s", s.interval)
+}
+
+func (s PeriodStrategy) Describe() (description string) {
+	return fmt.Sprintf("%s after every run", s.period)
+}
+
+func (s AlignedStrategy) Describe() (description string) {
+	description = fmt.Sprintf("every %s", s.period)
+	if s.offset != 0 {
+		description += fmt.Sprintf(" with offset %s", s.offset)
+	}
+	if s.midnight {
+		description += " since midnight"
+	}
+	return description
+}
+
+func (s TimetableStrategy) Describe() (description string) {
+	if len(s.timetable) == 0 {
+		return "never"
+	}
+	return describeAll(s.timetable)
+}
+
+func (s YearlyStrategy) Describe() (description string) {
+	months := make([]string, 0, len(s.months))
+	for _, month := range s.months {
+		months = append(months, month.String())
+	}
+	return fmt.Sprintf("on the %s day of %s every year at %s", describeDays(s.days), joinWords(months), describeClocks(s.hours, s.minutes, s.seconds))
+}
+
+func (s MonthlyStrategy) Describe() (description string) {
+	return fmt.Sprintf("on the %s day of every month at %s", describeDays(s.days), describeClocks(s.hours, s.minutes, s.seconds))
+}
+
+func (s QuarterlyStrategy) Describe() (description string) {
+	description = fmt.Sprintf("on the %s day of the %s month of every quarter at %s", ordinal(s.day), ordinal(s.month), describeClock(s.hour, s.minute, s.second))
+	if s.start != time.January {
+		description += fmt.Sprintf(" of the fiscal year starting in %s", s.start)
+	}
+	return description
+}
+
+func (s NthWeekdayStrategy) Describe() (description string) {
+	return fmt.Sprintf("on the %s %s of every month at %s", ordinal(s.n), s.day, describeClock(s.hour, s.minute, s.second))
+}
+
+func (s WeeklyStrategy) Describe() (description string) {
+	return fmt.Sprintf("every %s at %s", describeWeekdays(s.days), describeClocks(s.hours, s.minutes, s.seconds))
+}
+
+func (s DailyStrategy) Describe() (description string) {
+	return fmt.Sprintf("every day at %s", describeClocks(s.hours, s.minutes, s.seconds))
+}
+
+func (s EveryNthWeekStrategy) Describe() (description string) {
+	week := "every week"
+	if s.n != 1 {
+		week = fmt.Sprintf("every %s week", ordinal(s.n))
+	}
+	return fmt.Sprintf("%s on %s at %s", week, s.day, describeClock(s.hour, s.minute, s.second))
+}
+
+func (s HourlyStrategy) Describe() (description string) {
+	if s.minute == 0 && s.second == 0 {
+		return "every hour on the hour"
+	}
+	offset := plural(s.minute, "minute")
+	if s.second != 0 {
+		offset += " " + plural(s.second, "second")
+	}
+	return fmt.Sprintf("every hour at %s past the hour", offset)
+}
+
+func (s CronStrategy) Describe() (description string) {
+	clock, simple := s.describeTime()
+	parts := []string{clock}
+	if date := s.describeDate(); date != "" {
+		parts = append(parts, "on "+date)
+	}
+	if s.months != cronAll(cronMonths) {
+		parts = append(parts, "in "+describeValues(s.months.values(), monthName))
+	}
+	if s.years != nil {
+		years := make([]int, 0, len(s.years))
+		for year := range s.years {
+			years = append(years, year)
+		}
+		parts = append(parts, "in "+describeUnits("year", years, strconv.Itoa))
+	}
+	if len(parts) == 1 && simple {
+		parts[0] = "every day " + clock
+	}
+	if s.location != nil {
+		parts = append(parts, "in "+s.location.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+// describeTime returns wall clocks, when there are few of them, or matching seconds, minutes and hours otherwise.
+func (s CronStrategy) describeTime() (description string, simple bool) {
+	if len(s.clocks) <= 4 {
+		items := make([]string, 0, len(s.clocks))
+		for _, c := range s.clocks {
+			items = append(items, describeClock(c.hour, c.minute, c.second))
+		}
+		return "at " + joinWords(items), true
+	}
+	var parts []string
+	every := false
+	for _, field := range []struct {
+		unit string
+		bits cronBits
+		r    cronRange
+	}{
+		{unit: "second", bits: s.seconds, r: cronSeconds},
+		{unit: "minute", bits: s.minutes, r: cronMinutes},
+		{unit: "hour", bits: s.hours, r: cronHours},
+	} {
+		switch {
+		case field.bits == cronAll(field.r):
+			// "every second of every minute" is just "every second"
+			if !every {
+				parts = append(parts, "every "+field.unit)
+				every = true
+			}
+		case field.unit == "second" && field.bits == 1:
+			// ticks at the beginning of the minute
+		default:
+			parts = append(parts, describeUnits(field.unit, field.bits.values(), strconv.Itoa))
+			every = false
+		}
+	}
+	description = strings.Join(parts, " of ")
+	if !strings.HasPrefix(description, "every") {
+		description = "at " + description
+	}
+	return description, false
+}
+
+// describeDate returns matching days of month and days of week, empty description means every day.
+func (s CronStrategy) describeDate() (description string) {
+	var days []string
+	if s.days != 0 {
+		days = append(days, "the "+describeValues(s.days.values(), ordinal)+" day")
+	}
+	for _, offset := range s.lastDays.values() {
+		days = append(days, "the "+ordinal(-offset-1)+" day")
+	}
+	for _, day := range s.nearestDays.values() {
+		days = append(days, "the weekday nearest the "+ordinal(day))
+	}
+	if s.lastWorkday {
+		days = append(days, "the last weekday")
+	}
+	var weekdays []string
+	ofMonth := false
+	if s.weekdays != 0 {
+		weekdays = append(weekdays, describeValues(s.weekdays.values(), weekdayName))
+	}
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if s.nthWeekdays[weekday] != 0 {
+			weekdays = append(weekdays, fmt.Sprintf("the %s %s", describeValues(s.nthWeekdays[weekday].values(), ordinal), weekday))
+			ofMonth = true
+		}
+		if s.lastWeekdays.has(int(weekday)) {
+			weekdays = append(weekdays, "the last "+weekday.String())
+			ofMonth = true
+		}
+	}
+	anyDay := s.days == cronAll(cronDays)
+	anyWeekday := s.weekdays == 1<<7-1
+	day := joinWords(days) + " of the month"
+	weekday := joinWords(weekdays)
+	if ofMonth {
+		weekday += " of the month"
+	}
+	switch {
+	case s.anyDay || s.anyWeekday:
+		// both fields have to match
+		if anyDay && anyWeekday {
+			return ""
+		}
+		if anyWeekday {
+			return day
+		}
+		if anyDay {
+			return weekday
+		}
+		return day + " and " + weekday
+	case anyDay || anyWeekday:
+		// any of the fields has to match
+		return ""
+	default:
+		return day + " or " + weekday
+	}
+}
+
+var rruleUnits = []string{"second", "minute", "hour", "day", "week", "month", "year"}
+
+func (s RRuleStrategy) Describe() (description string) {
+	unit := rruleUnits[s.frequency]
+	if s.count == 1 {
+		description = "once at " + describeTime(s.start)
+	} else {
+		description = s.describeRule(unit)
+	}
+	if len(s.dates) != 0 {
+		description += " and at " + describeTimes(s.dates)
+	}
+	if len(s.excluded) != 0 {
+		description += " except " + describeTimes(s.excluded)
+	}
+	return description
+}
+
+func (s RRuleStrategy) describeRule(unit string) (description string) {
+	description = "every " + unit
+	if s.interval != 1 {
+		description = fmt.Sprintf("every %s %s", ordinal(s.interval), unit)
+	}
+	// rule takes omitted month, day and weekday from DTSTART
+	months, monthDays, weekdays := s.byMonth, s.byMonthDay, s.byDay
+	switch {
+	case s.frequency == rruleWeekly && len(weekdays) == 0:
+		weekdays = []rruleWeekday{{weekday: s.start.Weekday()}}
+	case s.frequency == rruleMonthly && len(monthDays) == 0 && len(weekdays) == 0:
+		monthDays = []int{s.start.Day()}
+	case s.frequency == rruleYearly && len(monthDays) == 0 && len(weekdays) == 0:
+		monthDays = []int{s.start.Day()}
+		if len(months) == 0 {
+			months = []int{int(s.start.Month())}
+		}
+	}
+	if len(months) != 0 {
+		description += " in " + describeValues(months, monthName)
+	}
+	if len(monthDays) != 0 {
+		description += fmt.Sprintf(" on the %s day", describeDays(monthDays))
+	}
+	if len(weekdays) != 0 {
+		items := make([]string, 0, len(weekdays))
+		for _, weekday := range weekdays {
+			if weekday.n == 0 {
+				items = append(items, weekday.weekday.String())
+			} else {
+				items = append(items, fmt.Sprintf("the %s %s", ordinal(weekday.n), weekday.weekday))
+			}
+		}
+		description += " on " + joinWords(items)
+	}
+	hour, minute, second := s.start.Clock()
+	if s.frequency >= rruleDaily {
+		description += " at " + describeClocks(orDefault(s.byHour, hour), orDefault(s.byMinute, minute), orDefault(s.bySecond, second))
+	} else {
+		for _, field := range []struct {
+			unit   string
+			values []int
+		}{{unit: "hour", values: s.byHour}, {unit: "minute", values: s.byMinute}, {unit: "second", values: s.bySecond}} {
+			if len(field.values) != 0 {
+				description += " at " + describeUnits(field.unit, field.values, strconv.Itoa)
+			}
+		}
+	}
+	if len(s.bySetPos) != 0 {
+		description += fmt.Sprintf(", the %s of them in every %s", describeDays(s.bySetPos), unit)
+	}
+	description += " since " + describeTime(s.start)
+	if s.count != 0 {
+		description += ", " + plural(s.count, "time")
+	}
+	if !s.until.IsZero() {
+		description += " until " + describeTime(s.until)
+	}
+	return description
+}
+
+func (s *JitterStrategy) Describe() (description string) {
+	if s.min == -s.max {
+		return fmt.Sprintf("%s with random deviation up to %s", describe(s.strategy), s.max)
+	}
+	return fmt.Sprintf("%s with random deviation from %s to %s", describe(s.strategy), s.min, s.max)
+}
+
+func (s *BackoffStrategy) Describe() (description string) {
+	return fmt.Sprintf("%s, after failure retry in %s growing %v times up to %s", describe(s.strategy), s.initial, s.multiplier, s.max)
+}
+
+func (s *TimesStrategy) Describe() (description string) {
+	return fmt.Sprintf("%s, %d times at most", describe(s.strategy), s.n)
+}
+
+func (s *OnceStrategy) Describe() (description string) {
+	return fmt.Sprintf("once at %s", describeTime(s.time))
+}
+
+func (s *OnStartStrategy) Describe() (description string) {
+	return "once on start"
+}
+
+func (s UntilStrategy) Describe() (description string) {
+	return fmt.Sprintf("%s until %s", describe(s.strategy), describeTime(s.deadline))
+}
+
+func (s AfterStrategy) Describe() (description string) {
+	return fmt.Sprintf("%s since %s", describe(s.strategy), describeTime(s.start))
+}
+
+func (s ActiveHoursStrategy) Describe() (description string) {
+	description = fmt.Sprintf("%s between %s and %s", describe(s.strategy), describeOffset(s.from), describeOffset(s.to))
+	if len(s.weekdays) != 0 {
+		description += " on " + describeWeekdays(s.weekdays)
+	}
+	return description
+}
+
+func (s IntersectStrategy) Describe() (description string) {
+	return fmt.Sprintf("when %s coincide", describeAll(s.strategies))
+}
+
+func (s ExceptStrategy) Describe() (description string) {
+	if s.predicate != nil {
+		return fmt.Sprintf("%s except filtered times", describe(s.base))
+	}
+	return fmt.Sprintf("%s except %s", describe(s.base), describeAll(s.excluded))
+}
+
+func (s BusinessDaysStrategy) Describe() (description string) {
+	return fmt.Sprintf("%s on business days", describe(s.strategy))
+}
+
+func (s *AdjustStrategy) Describe() (description string) {
+	adjustment := "following"
+	if s.adjustment >= 0 && int(s.adjustment) < len(adjustmentNames) {
+		adjustment = strings.ReplaceAll(adjustmentNames[s.adjustment], "_", " ")
+	}
+	return fmt.Sprintf("%s moved to the %s business day", describe(s.strategy), adjustment)
+}
+
+func (s NthBusinessDayStrategy) Describe() (description string) {
+	return fmt.Sprintf("on the %s business day of every month at %s", ordinal(s.n), describeClock(s.hour, s.minute, s.second))
+}
+
+func (s RandomStrategy) Describe() (description string) {
+	return fmt.Sprintf("at random time within %s after %s", s.length, describe(s.windows))
+}
+
+func (s SunStrategy) Describe() (description string) {
+	event := "sun event"
+	for _, e := range sunEvents {
+		if e.elevation == s.elevation && e.rising == s.rising {
+			event = strings.ReplaceAll(e.name, "_", " ")
+		}
+	}
+	switch {
+	case s.offset > 0:
+		event = fmt.Sprintf("%s after %s", s.offset, event)
+	case s.offset < 0:
+		event = fmt.Sprintf("%s before %s", -s.offset, event)
+	}
+	return fmt.Sprintf("%s at %v, %v", event, s.latitude, s.longitude)
+}
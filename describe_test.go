@@ -0,0 +1,74 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OnDescribe_ShouldReturnHumanReadableSchedule(t *testing.T) {
+	cron, err := Cron("*/15 9-18 * * MON-FRI")
+	require.NoError(t, err)
+	monthlyCron, err := Cron("0 0 25 * 7")
+	require.NoError(t, err)
+	quartz, err := Quartz("0 0 10 ? * 3#2 2025-2030")
+	require.NoError(t, err)
+	calendar, err := OnCalendar("*-02~01 12:00 Europe/Berlin")
+	require.NoError(t, err)
+	weeklyRule, err := RRule("DTSTART:20230103T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10")
+	require.NoError(t, err)
+	monthlyRule, err := RRule("DTSTART:20230106T180000Z\nRRULE:FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20231231T000000Z")
+	require.NoError(t, err)
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	holidays := Holidays()
+	for expected, strategy := range map[string]Strategy{
+		"every day at 10:00:00":                                                                   Daily(10, 0, 0),
+		"every day at 09:00:00, 09:30:00, 18:00:00 and 18:30:00":                                  DailyEach([]int{9, 18}, []int{0, 30}, []int{0}).WithGap(GapSkip),
+		"on the last day of every month at 00:00:00":                                              Monthly(-1, 0, 0, 0),
+		"on the 1st and 2nd last day of every month at 12:00:00":                                  MonthlyEach([]int{1, -2}, []int{12}, []int{0}, []int{0}),
+		"first at 2024-01-01 then every 1s":                                                       At(start, Interval(time.Second)),
+		"first in 1m0s then 1h0m0s after every run":                                               Delay(time.Minute, Period(time.Hour)),
+		"every Monday, Wednesday and Friday at 10:30:00":                                          WeeklyEach([]time.Weekday{time.Monday, time.Wednesday, time.Friday}, []int{10}, []int{30}, []int{0}),
+		"on the 8th day of March every year at 10:00:00":                                          Yearly(time.March, 8, 10, 0, 0),
+		"on the last Friday of every month at 17:00:00":                                           NthWeekday(-1, time.Friday, 17, 0, 0),
+		"on the 1st day of the 3rd month of every quarter at 09:00:00":                            Quarterly(3, 1, 9, 0, 0),
+		"every 2nd week on Monday at 09:00:00":                                                    EveryNthWeek(2, time.Monday, 9, 0, 0),
+		"every hour at 30 minutes past the hour":                                                  Hourly(30, 0),
+		"every hour at 1 minute 15 seconds past the hour":                                         Hourly(1, 15),
+		"every hour on the hour":                                                                  Hourly(0, 0),
+		"every 15m0s with offset 1m0s":                                                            Every(15*time.Minute, time.Minute),
+		"every day at 10:00:00 and every Sunday at 12:00:00":                                      Timetable(Daily(10, 0, 0), Weekly(time.Sunday, 12, 0, 0)),
+		"at minutes 0, 15, 30 and 45 of hours 9 through 18 on Monday through Friday":              cron,
+		"at 00:00:00 on the 25th day of the month or Sunday":                                      monthlyCron,
+		"at 10:00:00 on the 2nd Tuesday of the month in years 2025 through 2030":                  quartz,
+		"at 12:00:00 on the last day of the month in February in Europe/Berlin":                   calendar,
+		"every 2nd week on Monday and Wednesday at 09:00:00 since 2023-01-03 09:00:00, 10 times":  weeklyRule,
+		"every month on the last Friday at 18:00:00 since 2023-01-06 18:00:00 until 2023-12-31":   monthlyRule,
+		"every 1m0s between 09:00:00 and 18:00:00 on Monday":                                      ActiveHours(9*time.Hour, 18*time.Hour, Interval(time.Minute), time.Monday),
+		"every day at 09:00:00 except every Sunday at 09:00:00":                                   Except(Daily(9, 0, 0), Weekly(time.Sunday, 9, 0, 0)),
+		"on the last day of every month at 10:00:00 moved to the modified following business day": Adjust(holidays, ModifiedFollowing, Monthly(-1, 10, 0, 0)),
+		"on the 5th business day of every month at 10:00:00":                                      NthBusinessDay(holidays, 5, 10, 0, 0),
+		"15m0s before sunset at 59.9343, 30.3351":                                                 Sunset(59.9343, 30.3351, -15*time.Minute),
+		"every 1s, 3 times at most":                                                               Times(3, Interval(time.Second)),
+		"every 1m0s since 2024-01-01 until 2024-01-01 01:00:00":                                   Between(start, start.Add(time.Hour), Interval(time.Minute)),
+		"every 1h0m0s in Europe/Berlin":                                                           InLocation(mustLoadLocation(t, "Europe/Berlin"), Interval(time.Hour)),
+		"at random time within 4h0m0s after every day at 01:00:00":                                Random("maintenance", Daily(1, 0, 0), 4*time.Hour),
+		"once on start": OnStart(),
+	} {
+		describer, ok := strategy.(Describer)
+		require.True(t, ok, "%T", strategy)
+		assert.Equal(t, expected, describer.Describe())
+	}
+}
+
+func mustLoadLocation(t *testing.T, name string) (location *time.Location) {
+	location, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return location
+}
+
+func Test_OnScheduleDescribe_ShouldDescribeWrappedStrategy(t *testing.T) {
+	assert.Equal(t, "every day at 10:00:00", Schedule{Strategy: Daily(10, 0, 0)}.Describe())
+}
@@ -0,0 +1,879 @@
+package job
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Decoder creates strategy from JSON representation.
+type Decoder func(data []byte) (strategy Strategy, err error)
+
+var (
+	decodersMutex sync.RWMutex
+	decoders      = map[string]Decoder{}
+)
+
+// Register makes custom strategy available for UnmarshalStrategy and Schedule.
+// JSON representation of the strategy must be an object with "type" field equal to kind.
+// Register panics, if kind is already registered.
+func Register(kind string, decode Decoder) {
+	decodersMutex.Lock()
+	defer decodersMutex.Unlock()
+	if _, ok := decoders[kind]; ok {
+		panic(fmt.Sprintf("job: strategy type %q is already registered", kind))
+	}
+	decoders[kind] = decode
+}
+
+var builtinKinds = []string{
+	"interval", "period", "every", "delay", "at", "location", "timetable",
+	"yearly", "monthly", "quarterly", "nth_weekday", "weekly", "daily", "every_nth_week", "hourly",
+	"cron", "quartz", "on_calendar", "rrule", "jitter", "backoff",
+	"times", "once", "on_start", "until", "after", "active_hours", "intersect", "except",
+	"business_days", "adjust", "nth_business_day", "random", "sun",
+}
+
+func init() {
+	for _, kind := range builtinKinds {
+		Register(kind, decodeSpec)
+	}
+}
+
+// MarshalStrategy returns JSON representation of the strategy.
+// Strategies with functions, e.g. created by Function or ExceptFunc, can not be serialized.
+func MarshalStrategy(strategy Strategy) (data []byte, err error) {
+	if strategy == nil {
+		return nil, errors.New("strategy is nil")
+	}
+	if _, ok := strategy.(json.Marshaler); !ok {
+		return nil, fmt.Errorf("strategy %T is not serializable", strategy)
+	}
+	return json.Marshal(strategy)
+}
+
+// UnmarshalStrategy creates strategy from JSON representation with decoder registered for its type.
+func UnmarshalStrategy(data []byte) (strategy Strategy, err error) {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err = json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+	decodersMutex.RLock()
+	decode, ok := decoders[header.Type]
+	decodersMutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown strategy type %q", header.Type)
+	}
+	return decode(data)
+}
+
+// ToSpec returns representation of the strategy as generic map, e.g. for YAML encoding.
+func ToSpec(strategy Strategy) (spec map[string]interface{}, err error) {
+	data, err := MarshalStrategy(strategy)
+	if err != nil {
+		return nil, err
+	}
+	// numbers are decoded precisely, so large integers like jitter seed are not rounded to float
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(&spec); err != nil {
+		return nil, err
+	}
+	return normalizeSpec(spec).(map[string]interface{}), nil
+}
+
+// FromSpec creates strategy from generic map, e.g. decoded from YAML.
+func FromSpec(spec map[string]interface{}) (strategy Strategy, err error) {
+	data, err := json.Marshal(normalizeSpec(spec))
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalStrategy(data)
+}
+
+// normalizeSpec converts maps with interface keys, that are produced by some YAML decoders, to maps with string keys,
+// and JSON numbers to integers or floats.
+func normalizeSpec(value interface{}) (normalized interface{}) {
+	switch value := value.(type) {
+	case json.Number:
+		if n, err := value.Int64(); err == nil {
+			return n
+		}
+		f, _ := value.Float64()
+		return f
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			m[fmt.Sprint(k)] = normalizeSpec(v)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			m[k] = normalizeSpec(v)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(value))
+		for i, v := range value {
+			s[i] = normalizeSpec(v)
+		}
+		return s
+	default:
+		return value
+	}
+}
+
+// Schedule wraps strategy, so it can be used as a field of configuration decoded from JSON or YAML.
+type Schedule struct {
+	Strategy
+}
+
+func (s Schedule) MarshalJSON() (data []byte, err error) {
+	return MarshalStrategy(s.Strategy)
+}
+
+func (s *Schedule) UnmarshalJSON(data []byte) (err error) {
+	s.Strategy, err = UnmarshalStrategy(data)
+	return err
+}
+
+func (s Schedule) MarshalYAML() (value interface{}, err error) {
+	return ToSpec(s.Strategy)
+}
+
+func (s *Schedule) UnmarshalYAML(unmarshal func(value interface{}) error) (err error) {
+	var spec map[string]interface{}
+	if err = unmarshal(&spec); err != nil {
+		return err
+	}
+	s.Strategy, err = FromSpec(spec)
+	return err
+}
+
+type strategySpec struct {
+	Type          string           `json:"type"`
+	Expr          string           `json:"expr,omitempty"`
+	Rule          string           `json:"rule,omitempty"`
+	Key           string           `json:"key,omitempty"`
+	Event         string           `json:"event,omitempty"`
+	Interval      jsonDuration     `json:"interval,omitempty"`
+	Period        jsonDuration     `json:"period,omitempty"`
+	Delay         jsonDuration     `json:"delay,omitempty"`
+	Offset        jsonDuration     `json:"offset,omitempty"`
+	SinceMidnight bool             `json:"since_midnight,omitempty"`
+	Min           jsonDuration     `json:"min,omitempty"`
+	Max           jsonDuration     `json:"max,omitempty"`
+	Seed          *int64           `json:"seed,omitempty"`
+	Initial       jsonDuration     `json:"initial,omitempty"`
+	Multiplier    float64          `json:"multiplier,omitempty"`
+	Length        jsonDuration     `json:"length,omitempty"`
+	From          jsonDuration     `json:"from,omitempty"`
+	To            jsonDuration     `json:"to,omitempty"`
+	Time          *time.Time       `json:"time,omitempty"`
+	Start         *time.Time       `json:"start,omitempty"`
+	Deadline      *time.Time       `json:"deadline,omitempty"`
+	Location      string           `json:"location,omitempty"`
+	Latitude      float64          `json:"latitude,omitempty"`
+	Longitude     float64          `json:"longitude,omitempty"`
+	FirstMonth    jsonMonth        `json:"first_month,omitempty"`
+	N             int              `json:"n,omitempty"`
+	Year          int              `json:"year,omitempty"`
+	Week          int              `json:"week,omitempty"`
+	Month         int              `json:"month,omitempty"`
+	Day           int              `json:"day,omitempty"`
+	Weekday       jsonWeekday      `json:"weekday,omitempty"`
+	Hour          int              `json:"hour,omitempty"`
+	Minute        int              `json:"minute,omitempty"`
+	Second        int              `json:"second,omitempty"`
+	Months        []jsonMonth      `json:"months,omitempty"`
+	Days          []int            `json:"days,omitempty"`
+	Weekdays      []jsonWeekday    `json:"weekdays,omitempty"`
+	Hours         []int            `json:"hours,omitempty"`
+	Minutes       []int            `json:"minutes,omitempty"`
+	Seconds       []int            `json:"seconds,omitempty"`
+	Gap           *GapPolicy       `json:"gap,omitempty"`
+	Overlap       *OverlapPolicy   `json:"overlap,omitempty"`
+	Calendar      *HolidayCalendar `json:"calendar,omitempty"`
+	Adjustment    Adjustment       `json:"adjustment,omitempty"`
+	Strategy      *Schedule        `json:"strategy,omitempty"`
+	Strategies    []Schedule       `json:"strategies,omitempty"`
+	Excluded      []Schedule       `json:"excluded,omitempty"`
+}
+
+func decodeSpec(data []byte) (strategy Strategy, err error) {
+	var spec strategySpec
+	if err = json.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	strategy, err = spec.build()
+	if err == nil {
+		err = validate(strategy)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s strategy: %w", spec.Type, err)
+	}
+	return strategy, nil
+}
+
+// unmarshalSpec decodes built-in strategy into target, that must point to strategy of the same type.
+func unmarshalSpec(data []byte, target interface{}) (err error) {
+	strategy, err := decodeSpec(data)
+	if err != nil {
+		return err
+	}
+	value := reflect.ValueOf(strategy)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	targetValue := reflect.ValueOf(target).Elem()
+	if value.Type() != targetValue.Type() {
+		return fmt.Errorf("can not unmarshal %T into %T", strategy, target)
+	}
+	targetValue.Set(value)
+	return nil
+}
+
+func (spec strategySpec) build() (strategy Strategy, err error) {
+	switch spec.Type {
+	case "interval":
+		return Interval(time.Duration(spec.Interval)), nil
+	case "period":
+		return Period(time.Duration(spec.Period)), nil
+	case "every":
+		if spec.SinceMidnight {
+			return EverySinceMidnight(time.Duration(spec.Period), time.Duration(spec.Offset)), nil
+		}
+		return Every(time.Duration(spec.Period), time.Duration(spec.Offset)), nil
+	case "yearly":
+		months := make([]time.Month, 0, len(spec.Months))
+		for _, month := range spec.Months {
+			months = append(months, time.Month(month))
+		}
+		s := YearlyEach(months, spec.Days, spec.Hours, spec.Minutes, spec.Seconds)
+		s.policy = spec.policy(s.policy)
+		return s, nil
+	case "monthly":
+		s := MonthlyEach(spec.Days, spec.Hours, spec.Minutes, spec.Seconds)
+		s.policy = spec.policy(s.policy)
+		return s, nil
+	case "quarterly":
+		s := FiscalQuarterly(time.Month(spec.FirstMonth), spec.Month, spec.Day, spec.Hour, spec.Minute, spec.Second)
+		s.policy = spec.policy(s.policy)
+		return s, nil
+	case "nth_weekday":
+		s := NthWeekday(spec.N, time.Weekday(spec.Weekday), spec.Hour, spec.Minute, spec.Second)
+		s.policy = spec.policy(s.policy)
+		return s, nil
+	case "weekly":
+		s := WeeklyEach(spec.weekdays(), spec.Hours, spec.Minutes, spec.Seconds)
+		s.policy = spec.policy(s.policy)
+		return s, nil
+	case "daily":
+		s := DailyEach(spec.Hours, spec.Minutes, spec.Seconds)
+		s.policy = spec.policy(s.policy)
+		return s, nil
+	case "every_nth_week":
+		s := EveryNthWeek(spec.N, time.Weekday(spec.Weekday), spec.Hour, spec.Minute, spec.Second)
+		if spec.Year != 0 {
+			s = s.WithAnchor(spec.Year, spec.Week)
+		}
+		s.policy = spec.policy(s.policy)
+		return s, nil
+	case "hourly":
+		s := Hourly(spec.Minute, spec.Second)
+		s.policy = spec.policy(s.policy)
+		return s, nil
+	case "cron":
+		return Cron(spec.Expr)
+	case "quartz":
+		return Quartz(spec.Expr)
+	case "on_calendar":
+		return OnCalendar(spec.Expr)
+	case "rrule":
+		return RRule(spec.Rule)
+	case "once":
+		if spec.Time == nil {
+			return nil, errors.New("time is required")
+		}
+		return Once(*spec.Time), nil
+	case "on_start":
+		return OnStart(), nil
+	case "intersect":
+		strategies := strategiesOf(spec.Strategies)
+		if len(strategies) == 0 {
+			return nil, errors.New("strategies are required")
+		}
+		return Intersect(strategies[0], strategies[1:]...), nil
+	case "timetable":
+		return Timetable(strategiesOf(spec.Strategies)...), nil
+	case "nth_business_day":
+		if spec.Calendar == nil {
+			return nil, errors.New("calendar is required")
+		}
+		return NthBusinessDay(spec.Calendar, spec.N, spec.Hour, spec.Minute, spec.Second), nil
+	case "sun":
+		for _, event := range sunEvents {
+			if event.name == spec.Event {
+				return sunEvent(spec.Latitude, spec.Longitude, time.Duration(spec.Offset), event.elevation, event.rising), nil
+			}
+		}
+		return nil, fmt.Errorf("unknown event %q", spec.Event)
+	}
+	if spec.Strategy == nil || spec.Strategy.Strategy == nil {
+		return nil, errors.New("strategy is required")
+	}
+	inner := spec.Strategy.Strategy
+	switch spec.Type {
+	case "delay":
+		return Delay(time.Duration(spec.Delay), inner), nil
+	case "at":
+		if spec.Time == nil {
+			return nil, errors.New("time is required")
+		}
+		return At(*spec.Time, inner), nil
+	case "location":
+		location, err := time.LoadLocation(spec.Location)
+		if err != nil {
+			return nil, err
+		}
+		return InLocation(location, inner), nil
+	case "jitter":
+		s := ForwardJitter(time.Duration(spec.Max), inner)
+		s.min = time.Duration(spec.Min)
+		if spec.Seed != nil {
+			s.WithSeed(*spec.Seed)
+		}
+		return s, nil
+	case "backoff":
+		return Backoff(time.Duration(spec.Initial), spec.Multiplier, time.Duration(spec.Max), inner), nil
+	case "times":
+		return Times(spec.N, inner), nil
+	case "until":
+		if spec.Deadline == nil {
+			return nil, errors.New("deadline is required")
+		}
+		return Until(*spec.Deadline, inner), nil
+	case "after":
+		if spec.Start == nil {
+			return nil, errors.New("start is required")
+		}
+		return After(*spec.Start, inner), nil
+	case "active_hours":
+		return ActiveHours(time.Duration(spec.From), time.Duration(spec.To), inner, spec.weekdays()...), nil
+	case "except":
+		return Except(inner, strategiesOf(spec.Excluded)...), nil
+	case "random":
+		return Random(spec.Key, inner, time.Duration(spec.Length)), nil
+	}
+	if spec.Calendar == nil {
+		return nil, errors.New("calendar is required")
+	}
+	switch spec.Type {
+	case "business_days":
+		return BusinessDays(spec.Calendar, inner), nil
+	case "adjust":
+		return Adjust(spec.Calendar, spec.Adjustment, inner), nil
+	default:
+		return nil, fmt.Errorf("unknown strategy type %q", spec.Type)
+	}
+}
+
+func (spec strategySpec) policy(policy dstPolicy) (specified dstPolicy) {
+	if spec.Gap != nil {
+		policy.gap = *spec.Gap
+	}
+	if spec.Overlap != nil {
+		policy.overlap = *spec.Overlap
+	}
+	return policy
+}
+
+func (spec strategySpec) weekdays() (weekdays []time.Weekday) {
+	for _, weekday := range spec.Weekdays {
+		weekdays = append(weekdays, time.Weekday(weekday))
+	}
+	return weekdays
+}
+
+func strategiesOf(schedules []Schedule) (strategies []Strategy) {
+	for _, schedule := range schedules {
+		strategies = append(strategies, schedule.Strategy)
+	}
+	return strategies
+}
+
+func (spec strategySpec) withPolicy(policy dstPolicy) (s strategySpec) {
+	spec.Gap = &policy.gap
+	spec.Overlap = &policy.overlap
+	return spec
+}
+
+func schedule(strategy Strategy) (s *Schedule) {
+	return &Schedule{Strategy: strategy}
+}
+
+func schedules(strategies []Strategy) (s []Schedule) {
+	for _, strategy := range strategies {
+		s = append(s, Schedule{Strategy: strategy})
+	}
+	return s
+}
+
+func jsonWeekdays(weekdays []time.Weekday) (s []jsonWeekday) {
+	for _, weekday := range weekdays {
+		s = append(s, jsonWeekday(weekday))
+	}
+	return s
+}
+
+func holidayCalendar(calendar Calendar) (c *HolidayCalendar, err error) {
+	c, ok := calendar.(*HolidayCalendar)
+	if !ok {
+		return nil, fmt.Errorf("calendar %T is not serializable", calendar)
+	}
+	return c, nil
+}
+
+func timeRef(t time.Time) (ref *time.Time) {
+	return &t
+}
+
+var sunEvents = []struct {
+	name      string
+	elevation float64
+	rising    bool
+}{
+	{name: "sunrise", elevation: sunriseElevation, rising: true},
+	{name: "sunset", elevation: sunriseElevation, rising: false},
+	{name: "civil_dawn", elevation: civilElevation, rising: true},
+	{name: "civil_dusk", elevation: civilElevation, rising: false},
+	{name: "nautical_dawn", elevation: nauticalElevation, rising: true},
+	{name: "nautical_dusk", elevation: nauticalElevation, rising: false},
+}
+
+type jsonDuration time.Duration
+
+func (d jsonDuration) MarshalText() (text []byte, err error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+func (d *jsonDuration) UnmarshalText(text []byte) (err error) {
+	duration, err := time.ParseDuration(string(text))
+	*d = jsonDuration(duration)
+	return err
+}
+
+type jsonWeekday time.Weekday
+
+func (w jsonWeekday) MarshalText() (text []byte, err error) {
+	return []byte(time.Weekday(w).String()), nil
+}
+
+func (w *jsonWeekday) UnmarshalText(text []byte) (err error) {
+	weekday, ok := naturalWeekdays[strings.ToLower(string(text))]
+	if !ok {
+		return fmt.Errorf("unknown weekday %q", text)
+	}
+	*w = jsonWeekday(weekday)
+	return nil
+}
+
+type jsonMonth time.Month
+
+func (m jsonMonth) MarshalText() (text []byte, err error) {
+	return []byte(time.Month(m).String()), nil
+}
+
+func (m *jsonMonth) UnmarshalText(text []byte) (err error) {
+	month, ok := naturalMonths[strings.ToLower(string(text))]
+	if !ok {
+		return fmt.Errorf("unknown month %q", text)
+	}
+	*m = jsonMonth(month)
+	return nil
+}
+
+var gapPolicyNames = []string{"shift", "skip"}
+
+func (p GapPolicy) MarshalText() (text []byte, err error) {
+	return marshalName(gapPolicyNames, int(p))
+}
+
+func (p *GapPolicy) UnmarshalText(text []byte) (err error) {
+	index, err := unmarshalName(gapPolicyNames, text)
+	*p = GapPolicy(index)
+	return err
+}
+
+var overlapPolicyNames = []string{"first", "second", "both"}
+
+func (p OverlapPolicy) MarshalText() (text []byte, err error) {
+	return marshalName(overlapPolicyNames, int(p))
+}
+
+func (p *OverlapPolicy) UnmarshalText(text []byte) (err error) {
+	index, err := unmarshalName(overlapPolicyNames, text)
+	*p = OverlapPolicy(index)
+	return err
+}
+
+var adjustmentNames = []string{"following", "preceding", "modified_following"}
+
+func (a Adjustment) MarshalText() (text []byte, err error) {
+	return marshalName(adjustmentNames, int(a))
+}
+
+func (a *Adjustment) UnmarshalText(text []byte) (err error) {
+	index, err := unmarshalName(adjustmentNames, text)
+	*a = Adjustment(index)
+	return err
+}
+
+func marshalName(names []string, index int) (text []byte, err error) {
+	if index < 0 || index >= len(names) {
+		return nil, fmt.Errorf("unknown value %d", index)
+	}
+	return []byte(names[index]), nil
+}
+
+func unmarshalName(names []string, text []byte) (index int, err error) {
+	for i, name := range names {
+		if name == string(text) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown value %q, expected one of %q", text, names)
+}
+
+type holidayCalendarSpec struct {
+	Weekend  []jsonWeekday `json:"weekend"`
+	Holidays []string      `json:"holidays"`
+}
+
+func (c *HolidayCalendar) MarshalJSON() (data []byte, err error) {
+	spec := holidayCalendarSpec{Weekend: append([]jsonWeekday{}, jsonWeekdays(c.weekend)...), Holidays: []string{}}
+	for date := range c.holidays {
+		spec.Holidays = append(spec.Holidays, time.Date(date.year, date.month, date.day, 0, 0, 0, 0, time.UTC).Format(holidayDateLayout))
+	}
+	sort.Strings(spec.Holidays)
+	return json.Marshal(spec)
+}
+
+func (c *HolidayCalendar) UnmarshalJSON(data []byte) (err error) {
+	var spec holidayCalendarSpec
+	if err = json.Unmarshal(data, &spec); err != nil {
+		return err
+	}
+	*c = *Holidays()
+	if spec.Weekend != nil {
+		c.weekend = make([]time.Weekday, 0, len(spec.Weekend))
+		for _, weekday := range spec.Weekend {
+			c.weekend = append(c.weekend, time.Weekday(weekday))
+		}
+	}
+	for _, holiday := range spec.Holidays {
+		date, err := time.Parse(holidayDateLayout, holiday)
+		if err != nil {
+			return err
+		}
+		c.Add(date)
+	}
+	return nil
+}
+
+func (s *DelayStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "delay", Delay: jsonDuration(s.delay), Strategy: schedule(s.strategy)})
+}
+
+func (s *DelayStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s *AtStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "at", Time: timeRef(s.time), Strategy: schedule(s.strategy)})
+}
+
+func (s *AtStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s LocationStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "location", Location: s.location.String(), Strategy: schedule(s.strategy)})
+}
+
+func (s *LocationStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s IntervalStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "interval", Interval: jsonDuration(s.interval)})
+}
+
+func (s *IntervalStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s PeriodStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "period", Period: jsonDuration(s.period)})
+}
+
+func (s *PeriodStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s AlignedStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "every", Period: jsonDuration(s.period), Offset: jsonDuration(s.offset), SinceMidnight: s.midnight})
+}
+
+func (s *AlignedStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s TimetableStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "timetable", Strategies: schedules(s.timetable)})
+}
+
+func (s *TimetableStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s YearlyStrategy) MarshalJSON() (data []byte, err error) {
+	months := make([]jsonMonth, 0, len(s.months))
+	for _, month := range s.months {
+		months = append(months, jsonMonth(month))
+	}
+	return json.Marshal(strategySpec{Type: "yearly", Months: months, Days: s.days, Hours: s.hours, Minutes: s.minutes, Seconds: s.seconds}.withPolicy(s.policy))
+}
+
+func (s *YearlyStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s MonthlyStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "monthly", Days: s.days, Hours: s.hours, Minutes: s.minutes, Seconds: s.seconds}.withPolicy(s.policy))
+}
+
+func (s *MonthlyStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s QuarterlyStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "quarterly", FirstMonth: jsonMonth(s.start), Month: s.month, Day: s.day, Hour: s.hour, Minute: s.minute, Second: s.second}.withPolicy(s.policy))
+}
+
+func (s *QuarterlyStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s NthWeekdayStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "nth_weekday", N: s.n, Weekday: jsonWeekday(s.day), Hour: s.hour, Minute: s.minute, Second: s.second}.withPolicy(s.policy))
+}
+
+func (s *NthWeekdayStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s WeeklyStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "weekly", Weekdays: jsonWeekdays(s.days), Hours: s.hours, Minutes: s.minutes, Seconds: s.seconds}.withPolicy(s.policy))
+}
+
+func (s *WeeklyStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s DailyStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "daily", Hours: s.hours, Minutes: s.minutes, Seconds: s.seconds}.withPolicy(s.policy))
+}
+
+func (s *DailyStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s EveryNthWeekStrategy) MarshalJSON() (data []byte, err error) {
+	year, week := s.anchor.ISOWeek()
+	return json.Marshal(strategySpec{Type: "every_nth_week", N: s.n, Year: year, Week: week, Weekday: jsonWeekday(s.day), Hour: s.hour, Minute: s.minute, Second: s.second}.withPolicy(s.policy))
+}
+
+func (s *EveryNthWeekStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s HourlyStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "hourly", Minute: s.minute, Second: s.second}.withPolicy(s.policy))
+}
+
+func (s *HourlyStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s CronStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: s.dialect, Expr: s.expr})
+}
+
+func (s *CronStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s RRuleStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "rrule", Rule: s.text})
+}
+
+func (s *RRuleStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s *JitterStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "jitter", Min: jsonDuration(s.min), Max: jsonDuration(s.max), Seed: s.explicitSeed(), Strategy: schedule(s.strategy)})
+}
+
+func (s *JitterStrategy) explicitSeed() (seed *int64) {
+	if !s.seeded {
+		return nil
+	}
+	return &s.seed
+}
+
+func (s *JitterStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s *BackoffStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "backoff", Initial: jsonDuration(s.initial), Multiplier: s.multiplier, Max: jsonDuration(s.max), Strategy: schedule(s.strategy)})
+}
+
+func (s *BackoffStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s *TimesStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "times", N: s.n, Strategy: schedule(s.strategy)})
+}
+
+func (s *TimesStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s *OnceStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "once", Time: timeRef(s.time)})
+}
+
+func (s *OnceStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s *OnStartStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "on_start"})
+}
+
+func (s *OnStartStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s UntilStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "until", Deadline: timeRef(s.deadline), Strategy: schedule(s.strategy)})
+}
+
+func (s *UntilStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s AfterStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "after", Start: timeRef(s.start), Strategy: schedule(s.strategy)})
+}
+
+func (s *AfterStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s ActiveHoursStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "active_hours", From: jsonDuration(s.from), To: jsonDuration(s.to), Weekdays: jsonWeekdays(s.weekdays), Strategy: schedule(s.strategy)})
+}
+
+func (s *ActiveHoursStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s IntersectStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "intersect", Strategies: schedules(s.strategies)})
+}
+
+func (s *IntersectStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s ExceptStrategy) MarshalJSON() (data []byte, err error) {
+	if s.predicate != nil {
+		return nil, errors.New("strategy with predicate is not serializable")
+	}
+	return json.Marshal(strategySpec{Type: "except", Strategy: schedule(s.base), Excluded: schedules(s.excluded)})
+}
+
+func (s *ExceptStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s BusinessDaysStrategy) MarshalJSON() (data []byte, err error) {
+	calendar, err := holidayCalendar(s.calendar)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(strategySpec{Type: "business_days", Calendar: calendar, Strategy: schedule(s.strategy)})
+}
+
+func (s *BusinessDaysStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s *AdjustStrategy) MarshalJSON() (data []byte, err error) {
+	calendar, err := holidayCalendar(s.calendar)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(strategySpec{Type: "adjust", Calendar: calendar, Adjustment: s.adjustment, Strategy: schedule(s.strategy)})
+}
+
+func (s *AdjustStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s NthBusinessDayStrategy) MarshalJSON() (data []byte, err error) {
+	calendar, err := holidayCalendar(s.calendar)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(strategySpec{Type: "nth_business_day", Calendar: calendar, N: s.n, Hour: s.hour, Minute: s.minute, Second: s.second})
+}
+
+func (s *NthBusinessDayStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s RandomStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(strategySpec{Type: "random", Key: s.key, Length: jsonDuration(s.length), Strategy: schedule(s.windows)})
+}
+
+func (s *RandomStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
+
+func (s SunStrategy) MarshalJSON() (data []byte, err error) {
+	for _, event := range sunEvents {
+		if event.elevation == s.elevation && event.rising == s.rising {
+			return json.Marshal(strategySpec{Type: "sun", Event: event.name, Latitude: s.latitude, Longitude: s.longitude, Offset: jsonDuration(s.offset)})
+		}
+	}
+	return nil, fmt.Errorf("sun elevation %v is not serializable", s.elevation)
+}
+
+func (s *SunStrategy) UnmarshalJSON(data []byte) (err error) {
+	return unmarshalSpec(data, s)
+}
@@ -0,0 +1,207 @@
+package job
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OnMarshalStrategy_ShouldReturnDeclarativeRepresentation(t *testing.T) {
+	data, err := MarshalStrategy(Delay(time.Minute, Daily(10, 30, 0)))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "delay",
+		"delay": "1m0s",
+		"strategy": {"type": "daily", "hours": [10], "minutes": [30], "seconds": [0], "gap": "shift", "overlap": "first"}
+	}`, string(data))
+}
+
+func Test_OnUnmarshalStrategyAfterMarshalStrategy_ShouldReturnSameStrategy(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	rrule, err := RRule("DTSTART:20230101T100000Z\nRRULE:FREQ=DAILY;COUNT=3")
+	require.NoError(t, err)
+	cron, err := Cron("*/15 9-18 * * MON-FRI")
+	require.NoError(t, err)
+	quartz, err := Quartz("0 0 10 ? * 3#2")
+	require.NoError(t, err)
+	calendar, err := OnCalendar("Mon..Fri *-*-* 09:00:00 UTC")
+	require.NoError(t, err)
+	start := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.UTC)
+	holidays := Holidays(time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC)).WithWeekend(time.Friday, time.Saturday)
+	for _, strategy := range []Strategy{
+		Interval(time.Hour),
+		Period(90 * time.Minute),
+		Every(15*time.Minute, time.Minute),
+		EverySinceMidnight(time.Hour, 0),
+		At(start, Interval(time.Hour)),
+		InLocation(location, Daily(9, 0, 0)),
+		Timetable(Monthly(10, 10, 0, 0), Monthly(25, 10, 0, 0)),
+		Yearly(time.March, 8, 10, 0, 0).WithGap(GapSkip),
+		MonthlyEach([]int{1, -1}, []int{0, 12}, []int{0}, []int{0}),
+		FiscalQuarterly(time.April, 3, -1, 18, 0, 0),
+		NthWeekday(-1, time.Friday, 17, 0, 0),
+		WeeklyEach([]time.Weekday{time.Sunday, time.Wednesday}, []int{9}, []int{0}, []int{0}),
+		EveryNthWeek(2, time.Monday, 9, 0, 0).WithAnchor(2023, 5),
+		Hourly(30, 0).WithOverlap(OverlapSecond),
+		cron,
+		quartz,
+		calendar,
+		rrule,
+		Jitter(time.Minute, Period(time.Hour)),
+		Backoff(time.Second, 2, time.Minute, Period(time.Hour)),
+		Times(3, Interval(time.Second)),
+		Once(start),
+		OnStart(),
+		Between(start, start.Add(time.Hour), Interval(time.Minute)),
+		ActiveHours(9*time.Hour, 18*time.Hour, Interval(time.Minute), time.Monday, time.Friday),
+		Intersect(Daily(9, 0, 0), Weekly(time.Monday, 9, 0, 0)),
+		Except(Daily(9, 0, 0), Weekly(time.Sunday, 9, 0, 0)),
+		BusinessDays(holidays, Daily(9, 0, 0)),
+		Adjust(holidays, ModifiedFollowing, Monthly(-1, 10, 0, 0)),
+		NthBusinessDay(holidays, 5, 10, 0, 0),
+		Random("maintenance", Daily(1, 0, 0), 4*time.Hour),
+		CivilDusk(59.9343, 30.3351, -15*time.Minute),
+	} {
+		data, err := MarshalStrategy(strategy)
+		require.NoError(t, err, "%T", strategy)
+		decoded, err := UnmarshalStrategy(data)
+		require.NoError(t, err, string(data))
+		assert.IsType(t, strategy, decoded, string(data))
+		decodedData, err := MarshalStrategy(decoded)
+		require.NoError(t, err, string(data))
+		assert.JSONEq(t, string(data), string(decodedData))
+	}
+}
+
+func Test_OnRoundTripOfSeededJitterStrategy_ShouldReturnSameTickTimes(t *testing.T) {
+	from := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.UTC)
+	strategy := Jitter(time.Minute, Daily(10, 0, 0)).WithSeed(1676633942000000042)
+	data, err := MarshalStrategy(strategy)
+	require.NoError(t, err)
+	decoded, err := UnmarshalStrategy(data)
+	require.NoError(t, err)
+	spec, err := ToSpec(strategy)
+	require.NoError(t, err)
+	specDecoded, err := FromSpec(spec)
+	require.NoError(t, err)
+	expected := Preview(strategy, from, 5)
+	assert.Equal(t, expected, Preview(decoded, from, 5))
+	assert.Equal(t, expected, Preview(specDecoded, from, 5))
+}
+
+func Test_OnUnmarshalJSONOfConcreteStrategy_ShouldDecodeStrategyOfThisType(t *testing.T) {
+	var strategy DailyStrategy
+	err := json.Unmarshal([]byte(`{"type": "daily", "hours": [10], "minutes": [0], "seconds": [0]}`), &strategy)
+	assert.NoError(t, err)
+	assert.Equal(t, Daily(10, 0, 0), strategy)
+	err = json.Unmarshal([]byte(`{"type": "monthly", "days": [1], "hours": [10], "minutes": [0], "seconds": [0]}`), &strategy)
+	assert.Error(t, err)
+}
+
+func Test_OnScheduleInConfiguration_ShouldRoundTripStrategy(t *testing.T) {
+	type config struct {
+		Name     string   `json:"name"`
+		Schedule Schedule `json:"schedule"`
+	}
+	var c config
+	err := json.Unmarshal([]byte(`{"name": "report", "schedule": {"type": "weekly", "weekdays": ["monday", "Friday"], "hours": [9], "minutes": [30], "seconds": [0]}}`), &c)
+	assert.NoError(t, err)
+	assert.Equal(t, WeeklyEach([]time.Weekday{time.Monday, time.Friday}, []int{9}, []int{30}, []int{0}), c.Schedule.Strategy)
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	assert.Equal(t, time.Date(2023, time.February, 20, 9, 30, 0, 0, time.Local), c.Schedule.Tick(lastTickTime))
+}
+
+func Test_OnFromSpecWithInterfaceKeys_ShouldReturnStrategy(t *testing.T) {
+	strategy, err := FromSpec(map[string]interface{}{
+		"type":  "delay",
+		"delay": "1m",
+		"strategy": map[interface{}]interface{}{
+			"type":     "interval",
+			"interval": "1h",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, Delay(time.Minute, Interval(time.Hour)), strategy)
+	spec, err := ToSpec(strategy)
+	assert.NoError(t, err)
+	assert.Equal(t, "delay", spec["type"])
+}
+
+type registeredStrategy struct {
+	Every time.Duration `json:"every"`
+}
+
+func (s registeredStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return lastTickTime.Add(s.Every)
+}
+
+func (s registeredStrategy) MarshalJSON() (data []byte, err error) {
+	return json.Marshal(map[string]interface{}{"type": "registered", "every": s.Every})
+}
+
+var registerStrategy sync.Once
+
+func Test_OnRegisteredStrategy_ShouldDecodeNestedCustomStrategy(t *testing.T) {
+	registerStrategy.Do(func() {
+		Register("registered", func(data []byte) (strategy Strategy, err error) {
+			var s registeredStrategy
+			err = json.Unmarshal(data, &s)
+			return s, err
+		})
+	})
+	data, err := MarshalStrategy(Times(2, registeredStrategy{Every: time.Second}))
+	assert.NoError(t, err)
+	strategy, err := UnmarshalStrategy(data)
+	assert.NoError(t, err)
+	assert.Equal(t, Times(2, registeredStrategy{Every: time.Second}), strategy)
+	assert.Panics(t, func() {
+		Register("registered", nil)
+	})
+}
+
+func Test_OnMarshalStrategyWithFunction_ShouldReturnError(t *testing.T) {
+	never := func(_ time.Time) (nextTickTime time.Time) {
+		return time.Time{}
+	}
+	_, err := MarshalStrategy(Function(never))
+	assert.Error(t, err)
+	_, err = MarshalStrategy(Delay(time.Second, Function(never)))
+	assert.Error(t, err)
+	_, err = MarshalStrategy(ExceptFunc(Daily(9, 0, 0), func(tickTime time.Time) (excluded bool) {
+		return false
+	}))
+	assert.Error(t, err)
+}
+
+func Test_OnUnmarshalStrategyWithInvalidData_ShouldReturnError(t *testing.T) {
+	for _, data := range []string{
+		`[]`,
+		`{"type": "unknown"}`,
+		`{"type": "delay", "delay": "1m"}`,
+		`{"type": "interval", "interval": "one hour"}`,
+		`{"type": "weekly", "weekdays": ["someday"]}`,
+		`{"type": "daily", "hours": [9], "gap": "jump"}`,
+		`{"type": "cron", "expr": "* *"}`,
+		`{"type": "sun", "event": "moonrise"}`,
+	} {
+		_, err := UnmarshalStrategy([]byte(data))
+		assert.Error(t, err, data)
+	}
+}
+
+func Test_OnRoundTripOfJitterStrategyWithoutSeed_ShouldUseDifferentSeeds(t *testing.T) {
+	from := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.UTC)
+	data, err := MarshalStrategy(Jitter(time.Minute, Daily(10, 0, 0)))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "seed")
+	first, err := UnmarshalStrategy(data)
+	require.NoError(t, err)
+	second, err := UnmarshalStrategy(data)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Tick(from), second.Tick(from))
+}
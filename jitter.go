@@ -0,0 +1,86 @@
+package job
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Jitter shifts every tick of the strategy by a random duration in range [-deviation, deviation).
+func Jitter(deviation time.Duration, strategy Strategy) *JitterStrategy {
+	return (&JitterStrategy{
+		min:      -deviation,
+		max:      deviation,
+		strategy: strategy,
+	}).reseed(time.Now().UnixNano())
+}
+
+// ForwardJitter delays every tick of the strategy by a random duration in range [0, deviation).
+func ForwardJitter(deviation time.Duration, strategy Strategy) *JitterStrategy {
+	return (&JitterStrategy{
+		min:      0,
+		max:      deviation,
+		strategy: strategy,
+	}).reseed(time.Now().UnixNano())
+}
+
+var _ Strategy = (*JitterStrategy)(nil)
+
+type JitterStrategy struct {
+	min              time.Duration
+	max              time.Duration
+	seed             int64
+	seeded           bool
+	draws            int
+	random           *rand.Rand
+	strategy         Strategy
+	tickTime         time.Time
+	jitteredTickTime time.Time
+}
+
+// WithSeed makes random durations deterministic.
+// Only seed, that is set explicitly, is serialized, so replicas loading the same schedule get different durations.
+func (s *JitterStrategy) WithSeed(seed int64) *JitterStrategy {
+	s.seeded = true
+	return s.reseed(seed)
+}
+
+func (s *JitterStrategy) reseed(seed int64) *JitterStrategy {
+	s.seed = seed
+	s.draws = 0
+	s.random = rand.New(rand.NewSource(seed))
+	return s
+}
+
+func (s *JitterStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return s.jitter(s.strategy.Tick(s.underlyingTickTime(lastTickTime)))
+}
+
+func (s *JitterStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	return s.jitter(tickResult(s.strategy, s.underlyingTickTime(lastTickTime), err))
+}
+
+// underlyingTickTime allows underlying strategy to continue from its own tick time,
+// so deviations do not accumulate.
+func (s *JitterStrategy) underlyingTickTime(lastTickTime time.Time) (tickTime time.Time) {
+	if !s.jitteredTickTime.IsZero() && lastTickTime.Equal(s.jitteredTickTime) {
+		return s.tickTime
+	}
+	return lastTickTime
+}
+
+func (s *JitterStrategy) jitter(tickTime time.Time) (jitteredTickTime time.Time) {
+	if tickTime.IsZero() {
+		return tickTime
+	}
+	s.tickTime = tickTime
+	s.jitteredTickTime = tickTime.Add(s.deviation())
+	return s.jitteredTickTime
+}
+
+func (s *JitterStrategy) deviation() (deviation time.Duration) {
+	if s.max <= s.min {
+		return s.min
+	}
+	s.draws++
+	return s.min + time.Duration(s.random.Int63n(int64(s.max-s.min)))
+}
@@ -0,0 +1,52 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OnJitterStrategyTick_ShouldReturnTickTimeWithinDeviationOfUnderlyingStrategy(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := Jitter(time.Minute, Daily(10, 0, 0))
+	for i := 0; i < 100; i++ {
+		expected := Daily(10, 0, 0).Tick(lastTickTime)
+		nextTickTime := strategy.Tick(lastTickTime)
+		assert.False(t, nextTickTime.Before(expected.Add(-time.Minute)))
+		assert.True(t, nextTickTime.Before(expected.Add(time.Minute)))
+	}
+}
+
+func Test_OnForwardJitterStrategyTick_ShouldNotReturnTickTimeBeforeTickTimeOfUnderlyingStrategy(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := ForwardJitter(time.Minute, Daily(10, 0, 0))
+	for i := 0; i < 100; i++ {
+		expected := Daily(10, 0, 0).Tick(lastTickTime)
+		nextTickTime := strategy.Tick(lastTickTime)
+		assert.False(t, nextTickTime.Before(expected))
+		assert.True(t, nextTickTime.Before(expected.Add(time.Minute)))
+	}
+}
+
+func Test_OnJitterStrategyTickWithSameSeed_ShouldReturnSameTickTimes(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := Jitter(time.Minute, Interval(time.Hour)).WithSeed(42)
+	sameStrategy := Jitter(time.Minute, Interval(time.Hour)).WithSeed(42)
+	for i := 0; i < 10; i++ {
+		nextTickTime := strategy.Tick(lastTickTime)
+		assert.Equal(t, nextTickTime, sameStrategy.Tick(lastTickTime))
+		lastTickTime = nextTickTime
+	}
+}
+
+func Test_OnJitterStrategyConsecutiveTicks_ShouldNotAccumulateDeviation(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := Jitter(time.Minute, Interval(time.Hour))
+	for i := 1; i <= 100; i++ {
+		nextTickTime := strategy.Tick(lastTickTime)
+		expected := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local).Add(time.Duration(i) * time.Hour)
+		assert.InDelta(t, expected.UnixNano(), nextTickTime.UnixNano(), float64(time.Minute))
+		lastTickTime = nextTickTime
+	}
+}
@@ -9,13 +9,15 @@ import (
 
 type Payload func(ctx context.Context)
 
+type FalliblePayload func(ctx context.Context) (err error)
+
 const (
 	no = iota
 	yes
 )
 
 type Job struct {
-	payload  Payload
+	payload  FalliblePayload
 	strategy Strategy
 	started  uint32
 	used     uint32
@@ -25,6 +27,14 @@ type Job struct {
 }
 
 func New(payload Payload, strategy Strategy) (job *Job) {
+	return NewFallible(func(ctx context.Context) (err error) {
+		payload(ctx)
+		return nil
+	}, strategy)
+}
+
+// NewFallible creates job, which payload reports execution result to the strategy (see ResultStrategy).
+func NewFallible(payload FalliblePayload, strategy Strategy) (job *Job) {
 	return &Job{
 		payload:  payload,
 		strategy: strategy,
@@ -63,15 +73,22 @@ func (j *Job) StartContext(ctx context.Context) {
 func (j *Job) run(ctx context.Context) {
 	lastTickTime := time.Now()
 	nextTickTime := j.strategy.Tick(lastTickTime)
+	if nextTickTime.IsZero() {
+		return
+	}
 	timer := time.NewTimer(time.Until(nextTickTime))
 	defer timer.Stop()
 	for {
 		if !waitForTimerSignal(ctx, timer.C) {
 			return
 		}
-		j.payload(ctx)
+		err := j.payload(ctx)
 		lastTickTime = nextTickTime
-		nextTickTime = j.strategy.Tick(lastTickTime)
+		nextTickTime = tickResult(j.strategy, lastTickTime, err)
+		if nextTickTime.IsZero() {
+			// strategy has no more ticks
+			return
+		}
 		timer.Reset(time.Until(nextTickTime))
 	}
 }
@@ -81,7 +98,8 @@ func waitForTimerSignal(ctx context.Context, ch <-chan time.Time) (ok bool) {
 	case <-ctx.Done():
 		return false
 	case <-ch:
-		return true
+		// both channels may be ready, cancellation wins
+		return ctx.Err() == nil
 	}
 }
 
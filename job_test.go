@@ -2,6 +2,7 @@ package job
 
 import (
 	"context"
+	"errors"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -163,3 +164,67 @@ func Test_OnJobFinish_ShouldSendDoneSignal(t *testing.T) {
 	<-job.Done()
 	assert.NotEqual(t, uint32(0), atomic.LoadUint32(&counter))
 }
+
+func Test_OnStrategyReturnZeroTickTime_ShouldFinishJob(t *testing.T) {
+	var counter uint32
+	job := New(func(_ context.Context) {
+		atomic.AddUint32(&counter, 1)
+	}, Function(func(lastTickTime time.Time) (nextTickTime time.Time) {
+		if atomic.LoadUint32(&counter) == 2 {
+			return time.Time{}
+		}
+		return lastTickTime
+	}))
+
+	job.Start()
+	<-job.Done()
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&counter))
+}
+
+func Test_OnFalliblePayloadFailure_ShouldPassErrorToStrategy(t *testing.T) {
+	var counter uint32
+	failure := errors.New("failure")
+	var results []error
+	ctx, cancel := context.WithCancel(context.Background())
+	job := NewFallible(func(_ context.Context) (err error) {
+		if atomic.AddUint32(&counter, 1) == 1 {
+			return failure
+		}
+		return nil
+	}, resultStrategy(func(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+		results = append(results, err)
+		if len(results) == 3 {
+			cancel()
+			return lastTickTime.Add(time.Hour)
+		}
+		return lastTickTime
+	}))
+
+	job.StartContext(ctx)
+	assert.Equal(t, []error{nil, failure, nil}, results)
+}
+
+type resultStrategy func(lastTickTime time.Time, err error) (nextTickTime time.Time)
+
+func (s resultStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return s(lastTickTime, nil)
+}
+
+func (s resultStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	return s(lastTickTime, err)
+}
+
+func Test_OnStartJobWithLimitedStrategy_ShouldFinishJobAfterLastTick(t *testing.T) {
+	var counter uint32
+	job := New(func(_ context.Context) {
+		atomic.AddUint32(&counter, 1)
+	}, Times(3, Interval(100*time.Millisecond)))
+
+	go job.Start()
+	select {
+	case <-job.Done():
+	case <-time.After(time.Second):
+		t.Fatal("job is not finished")
+	}
+	assert.Equal(t, uint32(3), atomic.LoadUint32(&counter))
+}
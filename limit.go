@@ -0,0 +1,162 @@
+package job
+
+import (
+	"time"
+)
+
+// Times limits count of the strategy ticks, job finishes after the last one.
+func Times(n int, strategy Strategy) *TimesStrategy {
+	return &TimesStrategy{
+		n:        n,
+		count:    0,
+		strategy: strategy,
+	}
+}
+
+var _ ResultStrategy = (*TimesStrategy)(nil)
+
+type TimesStrategy struct {
+	n        int
+	count    int
+	strategy Strategy
+}
+
+func (s *TimesStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	if s.count >= s.n {
+		return time.Time{}
+	}
+	return s.counted(s.strategy.Tick(lastTickTime))
+}
+
+func (s *TimesStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	if s.count >= s.n {
+		return time.Time{}
+	}
+	return s.counted(tickResult(s.strategy, lastTickTime, err))
+}
+
+func (s *TimesStrategy) counted(tickTime time.Time) (nextTickTime time.Time) {
+	if !tickTime.IsZero() {
+		s.count++
+	}
+	return tickTime
+}
+
+// Once ticks at specified time, job finishes after it.
+func Once(time time.Time) *OnceStrategy {
+	return &OnceStrategy{
+		applied: false,
+		time:    time,
+	}
+}
+
+var _ Strategy = (*OnceStrategy)(nil)
+
+type OnceStrategy struct {
+	applied bool
+	time    time.Time
+}
+
+func (s *OnceStrategy) Tick(_ time.Time) (nextTickTime time.Time) {
+	if s.applied {
+		return time.Time{}
+	}
+	s.applied = true
+	return s.time
+}
+
+// OnStart ticks once right after job start, job finishes after it.
+func OnStart() *OnStartStrategy {
+	return &OnStartStrategy{
+		applied: false,
+	}
+}
+
+var _ Strategy = (*OnStartStrategy)(nil)
+
+type OnStartStrategy struct {
+	applied bool
+}
+
+func (s *OnStartStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	if s.applied {
+		return time.Time{}
+	}
+	s.applied = true
+	return lastTickTime
+}
+
+// Until stops the strategy ticks after deadline, job finishes at this moment.
+func Until(deadline time.Time, strategy Strategy) UntilStrategy {
+	return UntilStrategy{
+		deadline: deadline,
+		strategy: strategy,
+	}
+}
+
+var _ ResultStrategy = (*UntilStrategy)(nil)
+
+type UntilStrategy struct {
+	deadline time.Time
+	strategy Strategy
+}
+
+func (s UntilStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return s.limit(s.strategy.Tick(lastTickTime))
+}
+
+func (s UntilStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	return s.limit(tickResult(s.strategy, lastTickTime, err))
+}
+
+func (s UntilStrategy) limit(tickTime time.Time) (nextTickTime time.Time) {
+	if tickTime.After(s.deadline) {
+		return time.Time{}
+	}
+	return tickTime
+}
+
+// After skips the strategy ticks until start.
+// If the strategy does not tick after start, job ticks at start.
+func After(start time.Time, strategy Strategy) AfterStrategy {
+	return AfterStrategy{
+		start:    start,
+		strategy: strategy,
+	}
+}
+
+var _ ResultStrategy = (*AfterStrategy)(nil)
+
+type AfterStrategy struct {
+	start    time.Time
+	strategy Strategy
+}
+
+func (s AfterStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return s.skip(s.strategy.Tick(s.from(lastTickTime)))
+}
+
+func (s AfterStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	return s.skip(tickResult(s.strategy, s.from(lastTickTime), err))
+}
+
+// from returns time to tick the strategy from, the strategy is ticked once, because it can be stateful.
+func (s AfterStrategy) from(lastTickTime time.Time) (tickTime time.Time) {
+	if lastTickTime.Before(s.start) {
+		return s.start
+	}
+	return lastTickTime
+}
+
+func (s AfterStrategy) skip(tickTime time.Time) (nextTickTime time.Time) {
+	if !tickTime.IsZero() && tickTime.Before(s.start) {
+		// strategy does not depend on the last tick time
+		return s.start
+	}
+	return tickTime
+}
+
+// Between limits the strategy ticks to (from, to] window, job finishes after it.
+func Between(from time.Time, to time.Time, strategy Strategy) UntilStrategy {
+	return Until(to, After(from, strategy))
+}
@@ -0,0 +1,89 @@
+package job
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OnTimesStrategyTick_ShouldReturnTickTimeOfUnderlyingStrategySpecifiedNumberOfTimes(t *testing.T) {
+	lastTickTime := time.Now().Add(-2 * time.Second)
+	strategy := Times(2, Interval(time.Second))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, lastTickTime.Add(time.Second), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, lastTickTime.Add(2*time.Second), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.True(t, nextTickTime.IsZero())
+}
+
+func Test_OnOnceStrategyTick_ShouldReturnSpecifiedTickTimeOnlyOnce(t *testing.T) {
+	lastTickTime := time.Now().Add(-2 * time.Second)
+	strategy := Once(lastTickTime.Add(time.Second))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, lastTickTime.Add(time.Second), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.True(t, nextTickTime.IsZero())
+}
+
+func Test_OnUntilStrategyTickBeforeDeadline_ShouldReturnTickTimeOfUnderlyingStrategy(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := Until(time.Date(2023, time.February, 18, 10, 0, 0, 0, time.Local), Daily(10, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 18, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnUntilStrategyTickAfterDeadline_ShouldReturnZeroTickTime(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := Until(time.Date(2023, time.February, 18, 9, 0, 0, 0, time.Local), Daily(10, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.True(t, nextTickTime.IsZero())
+}
+
+func Test_OnAfterStrategyTickBeforeStart_ShouldReturnFirstTickTimeAfterStart(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := After(time.Date(2023, time.March, 1, 0, 0, 0, 0, time.Local), Daily(10, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 1, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnAfterStrategyTickBeforeStartWithPeriod_ShouldReturnStart(t *testing.T) {
+	start := time.Now().Add(time.Hour)
+	strategy := After(start, Period(time.Minute))
+	nextTickTime := strategy.Tick(time.Now())
+	assert.Equal(t, start, nextTickTime)
+}
+
+func Test_OnBetweenStrategyTick_ShouldReturnTickTimesOnlyWithinWindow(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := Between(time.Date(2023, time.March, 1, 0, 0, 0, 0, time.Local), time.Date(2023, time.March, 2, 12, 0, 0, 0, time.Local), Daily(10, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 1, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 2, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.True(t, nextTickTime.IsZero())
+}
+
+func Test_OnAfterStrategyTickBeforeStartWithTimes_ShouldNotConsumeTicks(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	start := lastTickTime.Add(10 * time.Hour)
+	strategy := After(start, Times(3, Interval(time.Hour)))
+	for _, expected := range []time.Time{start.Add(time.Hour), start.Add(2 * time.Hour), start.Add(3 * time.Hour)} {
+		nextTickTime := strategy.Tick(lastTickTime)
+		assert.Equal(t, expected, nextTickTime)
+		lastTickTime = nextTickTime
+	}
+	assert.True(t, strategy.Tick(lastTickTime).IsZero())
+}
+
+func Test_OnAfterStrategyTickBeforeStartWithBackoff_ShouldPassFailureOnce(t *testing.T) {
+	start := time.Now().Add(time.Hour)
+	backoff := Backoff(time.Second, 2, time.Minute, Interval(time.Hour))
+	strategy := After(start, backoff)
+	nextTickTime := strategy.TickResult(time.Now(), errors.New("failure"))
+	assert.Equal(t, start, nextTickTime)
+	assert.Equal(t, time.Second, backoff.delay)
+}
@@ -0,0 +1,337 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parse converts english schedule description into the corresponding strategy, e.g.
+// "every 15 minutes", "every day at 9am", "every monday and friday at 10:30",
+// "every weekday at 18:00", "on the last day of every month at midnight",
+// "on the second tuesday of every month at noon" or "every year on march 8 at 10:00".
+// Time is 00:00:00, when it is not specified.
+func Parse(text string) (strategy Strategy, err error) {
+	p := naturalParser{tokens: strings.Fields(strings.ToLower(strings.ReplaceAll(text, ",", " ")))}
+	strategy, err = p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("parse %q: %w", text, err)
+	}
+	return strategy, nil
+}
+
+var naturalUnits = map[string]time.Duration{
+	"second": time.Second,
+	"minute": time.Minute,
+	"hour":   time.Hour,
+	"day":    24 * time.Hour,
+	"week":   7 * 24 * time.Hour,
+}
+
+var naturalWeekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+var naturalMonths = map[string]time.Month{
+	"january":   time.January,
+	"february":  time.February,
+	"march":     time.March,
+	"april":     time.April,
+	"may":       time.May,
+	"june":      time.June,
+	"july":      time.July,
+	"august":    time.August,
+	"september": time.September,
+	"october":   time.October,
+	"november":  time.November,
+	"december":  time.December,
+}
+
+var naturalOrdinals = map[string]int{
+	"first":  1,
+	"second": 2,
+	"third":  3,
+	"fourth": 4,
+	"fifth":  5,
+	"last":   -1,
+}
+
+type naturalParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *naturalParser) parse() (strategy Strategy, err error) {
+	switch {
+	case p.accept("every"):
+		strategy, err = p.parseEvery()
+	case p.accept("on"):
+		strategy, err = p.parseOn()
+	case p.accept("daily"):
+		strategy, err = p.parseDaily()
+	case p.accept("hourly"):
+		strategy = Hourly(0, 0)
+	default:
+		return nil, p.unexpected()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, p.unexpected()
+	}
+	return strategy, nil
+}
+
+func (p *naturalParser) parseEvery() (strategy Strategy, err error) {
+	if n, ok := p.number(); ok {
+		unit, ok := p.unit()
+		if !ok || n <= 0 {
+			return nil, p.unexpected()
+		}
+		return Period(time.Duration(n) * unit), nil
+	}
+	switch {
+	case p.accept("day"):
+		return p.parseDaily()
+	case p.accept("weekday") || p.accept("weekdays"):
+		hour, minute, second, err := p.parseAt()
+		if err != nil {
+			return nil, err
+		}
+		return WeeklyEach(everyWorkday, []int{hour}, []int{minute}, []int{second}), nil
+	case p.accept("month"):
+		if !p.accept("on") {
+			return nil, p.unexpected()
+		}
+		p.accept("the")
+		return p.parseMonthDay(false)
+	case p.accept("year"):
+		if !p.accept("on") {
+			return nil, p.unexpected()
+		}
+		return p.parseYearDay(false)
+	}
+	if unit, ok := p.unit(); ok {
+		return Period(unit), nil
+	}
+	if p.atWeekday() {
+		return p.parseWeekdays()
+	}
+	return nil, p.unexpected()
+}
+
+var everyWorkday = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+func (p *naturalParser) parseOn() (strategy Strategy, err error) {
+	if p.atWeekday() {
+		return p.parseWeekdays()
+	}
+	if _, ok := naturalMonths[p.peek()]; ok {
+		return p.parseYearDay(true)
+	}
+	p.accept("the")
+	return p.parseMonthDay(true)
+}
+
+func (p *naturalParser) parseDaily() (strategy Strategy, err error) {
+	hour, minute, second, err := p.parseAt()
+	if err != nil {
+		return nil, err
+	}
+	return Daily(hour, minute, second), nil
+}
+
+func (p *naturalParser) parseWeekdays() (strategy Strategy, err error) {
+	var days []time.Weekday
+	for p.atWeekday() {
+		days = append(days, p.weekday())
+		p.accept("and")
+	}
+	hour, minute, second, err := p.parseAt()
+	if err != nil {
+		return nil, err
+	}
+	return WeeklyEach(days, []int{hour}, []int{minute}, []int{second}), nil
+}
+
+// parseMonthDay parses "last day", "15th", "second tuesday" followed by "of every month" if suffix is required.
+func (p *naturalParser) parseMonthDay(suffix bool) (strategy Strategy, err error) {
+	n, ok := p.ordinal()
+	if !ok {
+		return nil, p.unexpected()
+	}
+	isWeekday := p.atWeekday()
+	weekday := time.Sunday
+	if isWeekday {
+		weekday = p.weekday()
+	} else {
+		p.accept("day")
+	}
+	if suffix && !(p.accept("of") && p.accept("every") && p.accept("month")) {
+		return nil, p.unexpected()
+	}
+	hour, minute, second, err := p.parseAt()
+	if err != nil {
+		return nil, err
+	}
+	if isWeekday {
+		if n < 1 && n != -1 || n > 5 {
+			return nil, fmt.Errorf("%s %s of month is out of range", ordinal(n), weekday)
+		}
+		return NthWeekday(n, weekday, hour, minute, second), nil
+	}
+	if n < 1 && n != -1 || n > 31 {
+		return nil, fmt.Errorf("day %d is out of range", n)
+	}
+	return Monthly(n, hour, minute, second), nil
+}
+
+// parseYearDay parses "march 8" followed by "every year" if suffix is required.
+func (p *naturalParser) parseYearDay(suffix bool) (strategy Strategy, err error) {
+	month, ok := naturalMonths[p.next()]
+	if !ok {
+		p.pos--
+		return nil, p.unexpected()
+	}
+	day, ok := p.ordinal()
+	if !ok || day < 1 || day > 31 {
+		return nil, p.unexpected()
+	}
+	if suffix && !(p.accept("every") && p.accept("year")) {
+		return nil, p.unexpected()
+	}
+	hour, minute, second, err := p.parseAt()
+	if err != nil {
+		return nil, err
+	}
+	return Yearly(month, day, hour, minute, second), nil
+}
+
+// parseAt parses optional "at 10:30", "at 9am", "at 7:15:30 pm", "at midnight" or "at noon".
+func (p *naturalParser) parseAt() (hour int, minute int, second int, err error) {
+	if !p.accept("at") {
+		return 0, 0, 0, nil
+	}
+	switch token := p.next(); token {
+	case "midnight":
+		return 0, 0, 0, nil
+	case "noon":
+		return 12, 0, 0, nil
+	case "":
+		return 0, 0, 0, errors.New("time is expected")
+	default:
+		meridiem := ""
+		switch {
+		case strings.HasSuffix(token, "am") || strings.HasSuffix(token, "pm"):
+			meridiem = token[len(token)-2:]
+			token = token[:len(token)-2]
+		case p.peek() == "am" || p.peek() == "pm":
+			meridiem = p.next()
+		}
+		return parseNaturalClock(token, meridiem)
+	}
+}
+
+func parseNaturalClock(token string, meridiem string) (hour int, minute int, second int, err error) {
+	parts := strings.Split(token, ":")
+	if len(parts) > 3 {
+		return 0, 0, 0, fmt.Errorf("time %q is invalid", token)
+	}
+	values := [3]int{}
+	limits := [3]int{23, 59, 59}
+	for i, part := range parts {
+		if values[i], err = strconv.Atoi(part); err != nil || values[i] < 0 || values[i] > limits[i] {
+			return 0, 0, 0, fmt.Errorf("time %q is invalid", token)
+		}
+	}
+	hour, minute, second = values[0], values[1], values[2]
+	if meridiem != "" {
+		if hour < 1 || hour > 12 {
+			return 0, 0, 0, fmt.Errorf("time %q is invalid", token+meridiem)
+		}
+		hour %= 12
+		if meridiem == "pm" {
+			hour += 12
+		}
+	}
+	return hour, minute, second, nil
+}
+
+func (p *naturalParser) ordinal() (n int, ok bool) {
+	token := p.next()
+	if n, ok = naturalOrdinals[token]; ok {
+		return n, true
+	}
+	for _, suffix := range []string{"st", "nd", "rd", "th"} {
+		token = strings.TrimSuffix(token, suffix)
+	}
+	n, err := strconv.Atoi(token)
+	if err != nil {
+		p.pos--
+		return 0, false
+	}
+	return n, true
+}
+
+func (p *naturalParser) number() (n int, ok bool) {
+	n, err := strconv.Atoi(p.peek())
+	if err != nil {
+		return 0, false
+	}
+	p.pos++
+	return n, true
+}
+
+func (p *naturalParser) unit() (unit time.Duration, ok bool) {
+	unit, ok = naturalUnits[strings.TrimSuffix(p.peek(), "s")]
+	if ok {
+		p.pos++
+	}
+	return unit, ok
+}
+
+func (p *naturalParser) atWeekday() (ok bool) {
+	_, ok = naturalWeekdays[strings.TrimSuffix(p.peek(), "s")]
+	return ok
+}
+
+func (p *naturalParser) weekday() (day time.Weekday) {
+	return naturalWeekdays[strings.TrimSuffix(p.next(), "s")]
+}
+
+func (p *naturalParser) accept(word string) (ok bool) {
+	if p.peek() != word {
+		return false
+	}
+	p.pos++
+	return true
+}
+
+func (p *naturalParser) peek() (token string) {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *naturalParser) next() (token string) {
+	token = p.peek()
+	p.pos++
+	return token
+}
+
+func (p *naturalParser) unexpected() (err error) {
+	if p.pos >= len(p.tokens) {
+		return errors.New("unexpected end of text")
+	}
+	return fmt.Errorf("unexpected word %q", p.tokens[p.pos])
+}
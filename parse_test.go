@@ -0,0 +1,53 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OnParse_ShouldReturnCorrespondingStrategy(t *testing.T) {
+	workdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	for text, expected := range map[string]Strategy{
+		"every 15 minutes":      Period(15 * time.Minute),
+		"every hour":            Period(time.Hour),
+		"hourly":                Hourly(0, 0),
+		"every day at 9am":      Daily(9, 0, 0),
+		"daily at 7:15:30 pm":   Daily(19, 15, 30),
+		"every monday at 10:30": Weekly(time.Monday, 10, 30, 0),
+		"every Monday, Wednesday and Friday at 12 am":  WeeklyEach([]time.Weekday{time.Monday, time.Wednesday, time.Friday}, []int{0}, []int{0}, []int{0}),
+		"on saturdays at noon":                         Weekly(time.Saturday, 12, 0, 0),
+		"every weekday at 18:00":                       WeeklyEach(workdays, []int{18}, []int{0}, []int{0}),
+		"on the last day of every month at midnight":   Monthly(-1, 0, 0, 0),
+		"on the 15th of every month at 9:00":           Monthly(15, 9, 0, 0),
+		"every month on the first day":                 Monthly(1, 0, 0, 0),
+		"on the second tuesday of every month at noon": NthWeekday(2, time.Tuesday, 12, 0, 0),
+		"on the last friday of every month at 17:00":   NthWeekday(-1, time.Friday, 17, 0, 0),
+		"every year on march 8 at 10:00":               Yearly(time.March, 8, 10, 0, 0),
+		"on december 31st every year at 23:59:59":      Yearly(time.December, 31, 23, 59, 59),
+	} {
+		strategy, err := Parse(text)
+		assert.NoError(t, err, text)
+		assert.Equal(t, expected, strategy, text)
+	}
+}
+
+func Test_OnParseWithInvalidText_ShouldReturnError(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"sometimes",
+		"every 0 minutes",
+		"every day at 25:00",
+		"every day at 13pm",
+		"every monday at",
+		"on the 32nd of every month",
+		"on the 9th tuesday of every month",
+		"on the 6th friday of every month",
+		"on the last day of month",
+		"every day at noon please",
+	} {
+		_, err := Parse(text)
+		assert.Error(t, err, text)
+	}
+}
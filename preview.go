@@ -0,0 +1,257 @@
+package job
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Cloner is a strategy, that can be copied together with its state, so the copy ticks independently.
+// Stateful built-in strategies and strategies wrapping other strategies implement it.
+type Cloner interface {
+	Clone() (strategy Strategy)
+}
+
+func clone(strategy Strategy) (cloned Strategy) {
+	if c, ok := strategy.(Cloner); ok {
+		return c.Clone()
+	}
+	return strategy
+}
+
+func mapAll(strategies []Strategy, f func(strategy Strategy) (mapped Strategy)) (mapped []Strategy) {
+	mapped = make([]Strategy, 0, len(strategies))
+	for _, strategy := range strategies {
+		mapped = append(mapped, f(strategy))
+	}
+	return mapped
+}
+
+// wrapper is a strategy, that wraps other strategies.
+type wrapper interface {
+	// wrap returns copy of the strategy with wrapped strategies replaced by f.
+	wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy)
+}
+
+// simulate returns copy of the strategy, that ticks from the last tick time instead of the current time:
+// Period ticks as if the job took no time, not applied Delay ticks after from.
+func simulate(strategy Strategy, from time.Time) (simulated Strategy) {
+	switch s := strategy.(type) {
+	case PeriodStrategy:
+		return Interval(s.period)
+	case *DelayStrategy:
+		if !s.applied {
+			return At(from.Add(s.delay), simulate(s.strategy, from))
+		}
+	}
+	if w, ok := strategy.(wrapper); ok {
+		return w.wrap(func(strategy Strategy) (mapped Strategy) {
+			return simulate(strategy, from)
+		})
+	}
+	return clone(strategy)
+}
+
+// Preview returns up to n next tick times after from without running a job.
+// Stateful strategies are cloned, so the strategy is not affected.
+// Strategies based on the current time, like Period and Delay, are evaluated against from and previous tick times,
+// as if the job took no time.
+func Preview(strategy Strategy, from time.Time, n int) (tickTimes []time.Time) {
+	return preview(strategy, from, func(_ time.Time, count int) (ok bool) {
+		return count < n
+	})
+}
+
+// PreviewBetween returns tick times in range (from, to] without running a job, see Preview.
+func PreviewBetween(strategy Strategy, from time.Time, to time.Time) (tickTimes []time.Time) {
+	return preview(strategy, from, func(tickTime time.Time, _ int) (ok bool) {
+		return !tickTime.After(to)
+	})
+}
+
+// preview collects tick times after from, while accept returns true.
+// It stops, when the strategy finishes or does not move forward, and returns at most combinatorSearchLimit tick times.
+func preview(strategy Strategy, from time.Time, accept func(tickTime time.Time, count int) (ok bool)) (tickTimes []time.Time) {
+	strategy = simulate(strategy, from)
+	lastTickTime := from
+	var previousTickTime time.Time
+	for i := 0; i < combinatorSearchLimit; i++ {
+		nextTickTime := strategy.Tick(lastTickTime)
+		if nextTickTime.IsZero() || !previousTickTime.IsZero() && !nextTickTime.After(previousTickTime) {
+			return tickTimes
+		}
+		if nextTickTime.After(from) {
+			if !accept(nextTickTime, len(tickTimes)) {
+				return tickTimes
+			}
+			tickTimes = append(tickTimes, nextTickTime)
+		}
+		previousTickTime = nextTickTime
+		lastTickTime = nextTickTime
+	}
+	return tickTimes
+}
+
+func (s Schedule) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s Schedule) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	return Schedule{Strategy: f(s.Strategy)}
+}
+
+func (s *DelayStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s *DelayStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	c := *s
+	c.strategy = f(s.strategy)
+	return &c
+}
+
+func (s *AtStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s *AtStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	c := *s
+	c.strategy = f(s.strategy)
+	return &c
+}
+
+func (s LocationStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s LocationStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	s.strategy = f(s.strategy)
+	return s
+}
+
+func (s TimetableStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s TimetableStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	s.timetable = mapAll(s.timetable, f)
+	return s
+}
+
+// Clone replays random durations of the original, so the copy generates the same ones.
+func (s *JitterStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s *JitterStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	c := *s
+	c.strategy = f(s.strategy)
+	c.random = rand.New(rand.NewSource(s.seed))
+	for i := 0; i < s.draws; i++ {
+		c.random.Int63n(int64(s.max - s.min))
+	}
+	return &c
+}
+
+func (s *BackoffStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s *BackoffStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	c := *s
+	c.strategy = f(s.strategy)
+	return &c
+}
+
+func (s *TimesStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s *TimesStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	c := *s
+	c.strategy = f(s.strategy)
+	return &c
+}
+
+func (s *OnceStrategy) Clone() (strategy Strategy) {
+	c := *s
+	return &c
+}
+
+func (s *OnStartStrategy) Clone() (strategy Strategy) {
+	c := *s
+	return &c
+}
+
+func (s UntilStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s UntilStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	s.strategy = f(s.strategy)
+	return s
+}
+
+func (s AfterStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s AfterStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	s.strategy = f(s.strategy)
+	return s
+}
+
+func (s ActiveHoursStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s ActiveHoursStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	s.strategy = f(s.strategy)
+	return s
+}
+
+func (s IntersectStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s IntersectStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	s.strategies = mapAll(s.strategies, f)
+	return s
+}
+
+func (s ExceptStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s ExceptStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	s.base = f(s.base)
+	s.excluded = mapAll(s.excluded, f)
+	return s
+}
+
+func (s BusinessDaysStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s BusinessDaysStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	s.strategy = f(s.strategy)
+	return s
+}
+
+func (s *AdjustStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s *AdjustStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	c := *s
+	c.strategy = f(s.strategy)
+	return &c
+}
+
+func (s RandomStrategy) Clone() (strategy Strategy) {
+	return s.wrap(clone)
+}
+
+func (s RandomStrategy) wrap(f func(strategy Strategy) (mapped Strategy)) (strategy Strategy) {
+	s.windows = f(s.windows)
+	return s
+}
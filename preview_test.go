@@ -0,0 +1,83 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OnPreview_ShouldReturnNextTickTimes(t *testing.T) {
+	from := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.February, 18, 10, 0, 0, 0, time.Local),
+		time.Date(2023, time.February, 19, 10, 0, 0, 0, time.Local),
+		time.Date(2023, time.February, 20, 10, 0, 0, 0, time.Local),
+	}, Preview(Daily(10, 0, 0), from, 3))
+}
+
+func Test_OnPreviewOfStatefulStrategy_ShouldNotChangeStrategy(t *testing.T) {
+	from := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	at := time.Date(2023, time.February, 18, 0, 0, 0, 0, time.Local)
+	strategy := Times(2, At(at, Interval(time.Hour)))
+	expected := []time.Time{at, at.Add(time.Hour)}
+	assert.Equal(t, expected, Preview(strategy, from, 10))
+	assert.Equal(t, expected, Preview(strategy, from, 10))
+	assert.Equal(t, at, strategy.Tick(from))
+}
+
+func Test_OnPreviewOfDelayStrategy_ShouldCountDelayFromFrom(t *testing.T) {
+	strategy := Delay(time.Hour, Interval(time.Hour))
+	from := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	assert.Equal(t, []time.Time{from.Add(time.Hour), from.Add(2 * time.Hour)}, Preview(strategy, from, 2))
+	now := time.Now()
+	assert.WithinDuration(t, now.Add(time.Hour), strategy.Tick(now), time.Second)
+}
+
+func Test_OnPreviewOfPeriodStrategy_ShouldReturnTickTimesAfterPreviousTickTimes(t *testing.T) {
+	from := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	expected := []time.Time{from.Add(time.Hour), from.Add(2 * time.Hour), from.Add(3 * time.Hour)}
+	assert.Equal(t, expected, Preview(Period(time.Hour), from, 3))
+	assert.Equal(t, expected, Preview(Times(5, Period(time.Hour)), from, 3))
+	assert.Equal(t, expected[:2], PreviewBetween(Period(time.Hour), from, from.Add(2*time.Hour)))
+}
+
+func Test_OnPreviewBetweenOfDenseStrategy_ShouldLimitTickTimesCount(t *testing.T) {
+	from := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := Function(func(lastTickTime time.Time) (nextTickTime time.Time) {
+		return lastTickTime.Add(time.Nanosecond)
+	})
+	assert.Len(t, PreviewBetween(strategy, from, from.Add(time.Hour)), combinatorSearchLimit)
+}
+
+func Test_OnPreviewOfJitterStrategy_ShouldReturnSameTickTimesAsStrategy(t *testing.T) {
+	from := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := Jitter(time.Minute, Daily(10, 0, 0))
+	strategy.Tick(from)
+	tickTimes := Preview(strategy, from, 3)
+	lastTickTime := from
+	for _, tickTime := range tickTimes {
+		lastTickTime = strategy.Tick(lastTickTime)
+		assert.Equal(t, tickTime, lastTickTime)
+	}
+}
+
+func Test_OnPreviewOfStrategyStartedInThePast_ShouldReturnTickTimesAfterFrom(t *testing.T) {
+	from := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	at := time.Date(2023, time.February, 17, 9, 0, 0, 0, time.Local)
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.February, 17, 12, 0, 0, 0, time.Local),
+		time.Date(2023, time.February, 17, 13, 0, 0, 0, time.Local),
+	}, Preview(At(at, Interval(time.Hour)), from, 2))
+}
+
+func Test_OnPreviewBetween_ShouldReturnTickTimesInRange(t *testing.T) {
+	from := time.Date(2023, time.February, 17, 10, 0, 0, 0, time.Local)
+	to := time.Date(2023, time.February, 20, 10, 0, 0, 0, time.Local)
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.February, 18, 10, 0, 0, 0, time.Local),
+		time.Date(2023, time.February, 19, 10, 0, 0, 0, time.Local),
+		to,
+	}, PreviewBetween(Daily(10, 0, 0), from, to))
+	assert.Empty(t, PreviewBetween(Once(from), from, to))
+}
@@ -0,0 +1,52 @@
+package job
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"time"
+)
+
+// Random ticks exactly once within every window, that opens at tick of windows strategy and lasts for length.
+// Tick time within the window is derived from key and window opening,
+// so it does not change after restart and differs between jobs with different keys.
+// Windows strategy must be stateless, like calendar ones.
+func Random(key string, windows Strategy, length time.Duration) RandomStrategy {
+	return RandomStrategy{
+		key:     key,
+		windows: windows,
+		length:  length,
+	}
+}
+
+var _ Strategy = (*RandomStrategy)(nil)
+
+type RandomStrategy struct {
+	key     string
+	windows Strategy
+	length  time.Duration
+}
+
+func (s RandomStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	if s.length <= 0 {
+		return time.Time{}
+	}
+	// window, that opened less than length ago, may still contain tick time
+	opening := s.windows.Tick(lastTickTime.Add(-s.length))
+	for i := 0; i < combinatorSearchLimit && !opening.IsZero(); i++ {
+		nextTickTime = opening.Add(s.offset(opening))
+		if nextTickTime.After(lastTickTime) {
+			return nextTickTime
+		}
+		opening = s.windows.Tick(opening)
+	}
+	return time.Time{}
+}
+
+func (s RandomStrategy) offset(opening time.Time) (offset time.Duration) {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(s.key))
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(opening.UnixNano()))
+	_, _ = hash.Write(buf[:])
+	return time.Duration(hash.Sum64() % uint64(s.length))
+}
@@ -0,0 +1,34 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OnRandomStrategyTick_ShouldReturnTickTimeWithinEveryWindowExactlyOnce(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := Random("maintenance", Daily(1, 0, 0), 4*time.Hour)
+	for day := 18; day < 28; day++ {
+		nextTickTime := strategy.Tick(lastTickTime)
+		opening := time.Date(2023, time.February, day, 1, 0, 0, 0, time.Local)
+		assert.False(t, nextTickTime.Before(opening))
+		assert.True(t, nextTickTime.Before(opening.Add(4*time.Hour)))
+		lastTickTime = nextTickTime
+	}
+}
+
+func Test_OnRandomStrategyTickWithinWindow_ShouldReturnSameTickTimeAsBeforeWindow(t *testing.T) {
+	strategy := Random("maintenance", Daily(1, 0, 0), 4*time.Hour)
+	nextTickTime := strategy.Tick(time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local))
+	assert.Equal(t, nextTickTime, strategy.Tick(time.Date(2023, time.February, 18, 1, 0, 0, 0, time.Local).Add(-time.Nanosecond)))
+	assert.Equal(t, nextTickTime, strategy.Tick(nextTickTime.Add(-time.Nanosecond)))
+}
+
+func Test_OnRandomStrategyTickWithDifferentKeys_ShouldReturnDifferentTickTimes(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	nextTickTime := Random("first", Hourly(0, 0), time.Hour).Tick(lastTickTime)
+	otherNextTickTime := Random("second", Hourly(0, 0), time.Hour).Tick(lastTickTime)
+	assert.NotEqual(t, nextTickTime, otherNextTickTime)
+}
@@ -0,0 +1,621 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type rruleFrequency int
+
+const (
+	rruleSecondly rruleFrequency = iota
+	rruleMinutely
+	rruleHourly
+	rruleDaily
+	rruleWeekly
+	rruleMonthly
+	rruleYearly
+)
+
+var rruleFrequencies = map[string]rruleFrequency{
+	"SECONDLY": rruleSecondly,
+	"MINUTELY": rruleMinutely,
+	"HOURLY":   rruleHourly,
+	"DAILY":    rruleDaily,
+	"WEEKLY":   rruleWeekly,
+	"MONTHLY":  rruleMonthly,
+	"YEARLY":   rruleYearly,
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+type rruleWeekday struct {
+	n       int
+	weekday time.Weekday
+}
+
+// RRule parses iCalendar (RFC 5545) recurrence: DTSTART, RRULE, RDATE and EXDATE properties, one per line.
+// Rule parts FREQ, INTERVAL, COUNT, UNTIL, WKST, BYMONTH, BYMONTHDAY, BYDAY, BYHOUR, BYMINUTE, BYSECOND and BYSETPOS are supported.
+// DTSTART is required, date-time without TZID and "Z" suffix is interpreted in local time zone.
+func RRule(text string) (strategy RRuleStrategy, err error) {
+	strategy, err = parseRRule(text)
+	if err != nil {
+		return RRuleStrategy{}, fmt.Errorf("rrule: %w", err)
+	}
+	return strategy, nil
+}
+
+func parseRRule(text string) (s RRuleStrategy, err error) {
+	s.text = text
+	s.interval = 1
+	s.weekStart = time.Monday
+	hasRule := false
+	for _, line := range strings.FieldsFunc(text, func(r rune) bool { return r == '\n' || r == '\r' }) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		property, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return s, fmt.Errorf("line %q has no value", line)
+		}
+		name, params, _ := strings.Cut(property, ";")
+		switch strings.ToUpper(name) {
+		case "DTSTART":
+			if s.start, err = parseRRuleTime(params, value); err != nil {
+				return s, fmt.Errorf("DTSTART: %w", err)
+			}
+		case "RRULE":
+			if err = s.parseRule(value); err != nil {
+				return s, fmt.Errorf("RRULE: %w", err)
+			}
+			hasRule = true
+		case "RDATE", "EXDATE":
+			for _, item := range strings.Split(value, ",") {
+				dt, err := parseRRuleTime(params, item)
+				if err != nil {
+					return s, fmt.Errorf("%s: %w", name, err)
+				}
+				if strings.EqualFold(name, "RDATE") {
+					s.dates = append(s.dates, dt)
+				} else {
+					s.excluded = append(s.excluded, dt)
+				}
+			}
+		default:
+			return s, fmt.Errorf("unsupported property %q", name)
+		}
+	}
+	if s.start.IsZero() {
+		return s, errors.New("DTSTART is required")
+	}
+	if !hasRule {
+		// recurrence consists of DTSTART and RDATE only
+		s.count = 1
+	}
+	sort.Slice(s.dates, func(i, j int) bool {
+		return s.dates[i].Before(s.dates[j])
+	})
+	if !s.until.IsZero() && s.untilDate {
+		s.until = time.Date(s.until.Year(), s.until.Month(), s.until.Day(), 23, 59, 59, 0, s.start.Location())
+	}
+	if s.count != 0 {
+		s.last = s.lastOccurrence()
+	}
+	return s, nil
+}
+
+func parseRRuleTime(params string, value string) (dt time.Time, err error) {
+	location := time.Local
+	for _, param := range strings.Split(params, ";") {
+		key, paramValue, _ := strings.Cut(param, "=")
+		if strings.EqualFold(key, "TZID") {
+			if location, err = time.LoadLocation(paramValue); err != nil {
+				return time.Time{}, err
+			}
+		}
+	}
+	value = strings.TrimSpace(value)
+	switch {
+	case strings.HasSuffix(value, "Z"):
+		return time.Parse("20060102T150405Z", value)
+	case len(value) == len("20060102"):
+		return time.ParseInLocation("20060102", value, location)
+	default:
+		return time.ParseInLocation("20060102T150405", value, location)
+	}
+}
+
+func (s *RRuleStrategy) parseRule(rule string) (err error) {
+	hasFrequency := false
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return fmt.Errorf("rule part %q has no value", part)
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			if s.frequency, ok = rruleFrequencies[strings.ToUpper(value)]; !ok {
+				return fmt.Errorf("unknown frequency %q", value)
+			}
+			hasFrequency = true
+		case "INTERVAL":
+			if s.interval, err = strconv.Atoi(value); err != nil || s.interval <= 0 {
+				return fmt.Errorf("INTERVAL %q is not a positive number", value)
+			}
+		case "COUNT":
+			if s.count, err = strconv.Atoi(value); err != nil || s.count <= 0 {
+				return fmt.Errorf("COUNT %q is not a positive number", value)
+			}
+		case "UNTIL":
+			if s.until, err = parseRRuleTime("", value); err != nil {
+				return fmt.Errorf("UNTIL: %w", err)
+			}
+			s.untilDate = len(value) == len("20060102")
+		case "WKST":
+			if s.weekStart, ok = rruleWeekdays[strings.ToUpper(value)]; !ok {
+				return fmt.Errorf("unknown weekday %q", value)
+			}
+		case "BYMONTH":
+			if s.byMonth, err = parseRRuleNumbers(value, 1, 12, false); err != nil {
+				return fmt.Errorf("BYMONTH: %w", err)
+			}
+		case "BYMONTHDAY":
+			if s.byMonthDay, err = parseRRuleNumbers(value, 1, 31, true); err != nil {
+				return fmt.Errorf("BYMONTHDAY: %w", err)
+			}
+		case "BYHOUR":
+			if s.byHour, err = parseRRuleNumbers(value, 0, 23, false); err != nil {
+				return fmt.Errorf("BYHOUR: %w", err)
+			}
+		case "BYMINUTE":
+			if s.byMinute, err = parseRRuleNumbers(value, 0, 59, false); err != nil {
+				return fmt.Errorf("BYMINUTE: %w", err)
+			}
+		case "BYSECOND":
+			if s.bySecond, err = parseRRuleNumbers(value, 0, 59, false); err != nil {
+				return fmt.Errorf("BYSECOND: %w", err)
+			}
+		case "BYSETPOS":
+			if s.bySetPos, err = parseRRuleNumbers(value, 1, 366, true); err != nil {
+				return fmt.Errorf("BYSETPOS: %w", err)
+			}
+		case "BYDAY":
+			if s.byDay, err = parseRRuleWeekdays(value); err != nil {
+				return fmt.Errorf("BYDAY: %w", err)
+			}
+		default:
+			return fmt.Errorf("unsupported rule part %q", key)
+		}
+	}
+	if !hasFrequency {
+		return errors.New("FREQ is required")
+	}
+	if s.count != 0 && !s.until.IsZero() {
+		return errors.New("COUNT and UNTIL must not occur together")
+	}
+	return nil
+}
+
+func parseRRuleNumbers(value string, min int, max int, negative bool) (numbers []int, err error) {
+	for _, item := range strings.Split(value, ",") {
+		number, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", item)
+		}
+		abs := number
+		if negative && number < 0 {
+			abs = -number
+		}
+		if abs < min || abs > max {
+			return nil, fmt.Errorf("%d is out of range", number)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+func parseRRuleWeekdays(value string) (weekdays []rruleWeekday, err error) {
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("%q is not a weekday", item)
+		}
+		weekday, ok := rruleWeekdays[strings.ToUpper(item[len(item)-2:])]
+		if !ok {
+			return nil, fmt.Errorf("%q is not a weekday", item)
+		}
+		n := 0
+		if prefix := strings.TrimPrefix(item[:len(item)-2], "+"); prefix != "" {
+			n, err = strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("%q has invalid ordinal", item)
+			}
+		}
+		weekdays = append(weekdays, rruleWeekday{n: n, weekday: weekday})
+	}
+	return weekdays, nil
+}
+
+var _ Strategy = (*RRuleStrategy)(nil)
+
+type RRuleStrategy struct {
+	text       string
+	start      time.Time
+	frequency  rruleFrequency
+	interval   int
+	count      int
+	last       time.Time
+	until      time.Time
+	untilDate  bool
+	weekStart  time.Weekday
+	byMonth    []int
+	byMonthDay []int
+	byDay      []rruleWeekday
+	byHour     []int
+	byMinute   []int
+	bySecond   []int
+	bySetPos   []int
+	dates      []time.Time
+	excluded   []time.Time
+}
+
+// rruleSearchPeriods limits count of consecutive periods without occurrences, after which rule is considered finished.
+const rruleSearchPeriods = 100000
+
+func (s RRuleStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	nextTickTime = s.nextRuleTime(lastTickTime)
+	for _, dt := range s.dates {
+		if dt.After(lastTickTime) && !s.isExcluded(dt) {
+			if nextTickTime.IsZero() || dt.Before(nextTickTime) {
+				nextTickTime = dt
+			}
+			break
+		}
+	}
+	if nextTickTime.IsZero() {
+		return nextTickTime
+	}
+	return nextTickTime.In(lastTickTime.Location())
+}
+
+func (s RRuleStrategy) nextRuleTime(lastTickTime time.Time) (nextTickTime time.Time) {
+	if s.start.After(lastTickTime) && !s.isExcluded(s.start) {
+		return s.start
+	}
+	// earlier periods do not affect the result, COUNT is checked with the time of the last occurrence
+	empty := 0
+	for i := max(s.periodIndex(lastTickTime)-1, 0); empty < rruleSearchPeriods; i = s.nextPeriod(i) {
+		candidates, ok := s.period(i)
+		if !ok {
+			return time.Time{}
+		}
+		empty++
+		for _, candidate := range candidates {
+			if !candidate.After(s.start) {
+				continue
+			}
+			if !s.until.IsZero() && candidate.After(s.until) || s.count != 0 && candidate.After(s.last) {
+				return time.Time{}
+			}
+			empty = 0
+			if candidate.After(lastTickTime) && !s.isExcluded(candidate) {
+				return candidate
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// lastOccurrence returns time of the COUNT-th occurrence, DTSTART and excluded dates are counted too.
+func (s RRuleStrategy) lastOccurrence() (last time.Time) {
+	last = s.start
+	count := 1
+	empty := 0
+	for i := 0; count < s.count && empty < rruleSearchPeriods; i = s.nextPeriod(i) {
+		candidates, ok := s.period(i)
+		if !ok {
+			break
+		}
+		empty++
+		for _, candidate := range candidates {
+			if !candidate.After(s.start) || count == s.count {
+				continue
+			}
+			empty = 0
+			count++
+			last = candidate
+		}
+	}
+	return last
+}
+
+// nextPeriod returns index of the period after i-th one,
+// periods shorter than a day are skipped up to the next day, if the day does not match the rule.
+func (s RRuleStrategy) nextPeriod(i int) (next int) {
+	if s.frequency >= rruleDaily {
+		return i + 1
+	}
+	start := s.timePeriodStart(i)
+	if len(s.filterDates(start)) != 0 {
+		return i + 1
+	}
+	midnight := time.Date(start.Year(), start.Month(), start.Day()+1, 0, 0, 0, 0, start.Location())
+	return max(i+1, s.periodIndex(midnight))
+}
+
+func (s RRuleStrategy) isExcluded(dt time.Time) (ok bool) {
+	for _, excluded := range s.excluded {
+		if excluded.Equal(dt) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s RRuleStrategy) periodIndex(dt time.Time) (index int) {
+	dt = dt.In(s.start.Location())
+	var periods int
+	switch s.frequency {
+	case rruleYearly:
+		periods = dt.Year() - s.start.Year()
+	case rruleMonthly:
+		periods = (dt.Year()-s.start.Year())*12 + int(dt.Month()) - int(s.start.Month())
+	case rruleWeekly:
+		periods = daysBetween(s.start, dt) / 7
+	case rruleDaily:
+		periods = daysBetween(s.start, dt)
+	case rruleHourly:
+		periods = int(wallTime(dt).Sub(wallTime(s.start)) / time.Hour)
+	case rruleMinutely:
+		periods = int(wallTime(dt).Sub(wallTime(s.start)) / time.Minute)
+	default:
+		periods = int(wallTime(dt).Sub(wallTime(s.start)) / time.Second)
+	}
+	return periods / s.interval
+}
+
+// wallTime returns wall clock of dt as time in UTC, so periods are counted like in timePeriodStart.
+func wallTime(dt time.Time) (wall time.Time) {
+	year, month, day := dt.Date()
+	hour, minute, second := dt.Clock()
+	return time.Date(year, month, day, hour, minute, second, dt.Nanosecond(), time.UTC)
+}
+
+func daysBetween(a time.Time, b time.Time) (days int) {
+	aDate := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	bDate := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(bDate.Sub(aDate).Hours()) / 24
+}
+
+// period returns sorted occurrences of the i-th period, ok is false if period starts after UNTIL.
+func (s RRuleStrategy) period(i int) (candidates []time.Time, ok bool) {
+	start := s.start
+	year, month, day := start.Date()
+	hour, minute, second := start.Clock()
+	step := i * s.interval
+	var periodStart time.Time
+	var dates []time.Time
+	hours, minutes, seconds := orDefault(s.byHour, hour), orDefault(s.byMinute, minute), orDefault(s.bySecond, second)
+	switch s.frequency {
+	case rruleYearly:
+		periodStart = time.Date(year+step, time.January, 1, 0, 0, 0, 0, start.Location())
+		dates = s.yearDates(year + step)
+	case rruleMonthly:
+		periodStart = time.Date(year, month+time.Month(step), 1, 0, 0, 0, 0, start.Location())
+		if s.matchMonth(periodStart.Month()) {
+			dates = s.monthDates(periodStart.Year(), periodStart.Month())
+		}
+	case rruleWeekly:
+		weekStart := time.Date(year, month, day-mod(int(start.Weekday()-s.weekStart), 7)+7*step, 0, 0, 0, 0, time.UTC)
+		periodStart = time.Date(weekStart.Year(), weekStart.Month(), weekStart.Day(), 0, 0, 0, 0, start.Location())
+		for d := 0; d < 7; d++ {
+			date := weekStart.AddDate(0, 0, d)
+			if s.matchMonth(date.Month()) && s.matchWeekday(date.Weekday(), start.Weekday()) {
+				dates = append(dates, date)
+			}
+		}
+	case rruleDaily:
+		periodStart = time.Date(year, month, day+step, 0, 0, 0, 0, start.Location())
+		dates = s.filterDates(periodStart)
+	default:
+		periodStart = s.timePeriodStart(i)
+		hours = filterValue(s.byHour, periodStart.Hour())
+		if s.frequency != rruleHourly {
+			minutes = filterValue(s.byMinute, periodStart.Minute())
+		}
+		if s.frequency == rruleSecondly {
+			seconds = filterValue(s.bySecond, periodStart.Second())
+		}
+		dates = s.filterDates(periodStart)
+	}
+	if !s.until.IsZero() && periodStart.After(s.until) {
+		return nil, false
+	}
+	for _, date := range dates {
+		for _, h := range hours {
+			for _, m := range minutes {
+				for _, sec := range seconds {
+					candidates = append(candidates, time.Date(date.Year(), date.Month(), date.Day(), h, m, sec, 0, start.Location()))
+				}
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Before(candidates[j])
+	})
+	return s.setPositions(candidates), true
+}
+
+// timePeriodStart returns start of the i-th period of HOURLY, MINUTELY or SECONDLY rule.
+func (s RRuleStrategy) timePeriodStart(i int) (periodStart time.Time) {
+	year, month, day := s.start.Date()
+	hour, minute, second := s.start.Clock()
+	step := i * s.interval
+	switch s.frequency {
+	case rruleHourly:
+		return time.Date(year, month, day, hour+step, 0, 0, 0, s.start.Location())
+	case rruleMinutely:
+		return time.Date(year, month, day, hour, minute+step, 0, 0, s.start.Location())
+	default:
+		return time.Date(year, month, day, hour, minute, second+step, 0, s.start.Location())
+	}
+}
+
+func orDefault(values []int, value int) (result []int) {
+	if len(values) == 0 {
+		return []int{value}
+	}
+	return values
+}
+
+func filterValue(values []int, value int) (result []int) {
+	if len(values) == 0 || containsInt(values, value) {
+		return []int{value}
+	}
+	return nil
+}
+
+func containsInt(values []int, value int) (ok bool) {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (s RRuleStrategy) matchMonth(month time.Month) (ok bool) {
+	return len(s.byMonth) == 0 || containsInt(s.byMonth, int(month))
+}
+
+func (s RRuleStrategy) matchWeekday(weekday time.Weekday, defaultWeekday time.Weekday) (ok bool) {
+	if len(s.byDay) == 0 {
+		return weekday == defaultWeekday
+	}
+	for _, day := range s.byDay {
+		if day.weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+func (s RRuleStrategy) matchMonthDay(date time.Time) (ok bool) {
+	lastDay := dayCountInCurrentMonth(date)
+	for _, day := range s.byMonthDay {
+		if day == date.Day() || day < 0 && lastDay+day+1 == date.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// filterDates returns the date, if it matches BYMONTH, BYMONTHDAY and BYDAY.
+func (s RRuleStrategy) filterDates(dt time.Time) (dates []time.Time) {
+	date := time.Date(dt.Year(), dt.Month(), dt.Day(), 0, 0, 0, 0, time.UTC)
+	if !s.matchMonth(date.Month()) {
+		return nil
+	}
+	if len(s.byMonthDay) != 0 && !s.matchMonthDay(date) {
+		return nil
+	}
+	if len(s.byDay) != 0 && !s.matchWeekday(date.Weekday(), date.Weekday()) {
+		return nil
+	}
+	return []time.Time{date}
+}
+
+func (s RRuleStrategy) yearDates(year int) (dates []time.Time) {
+	if len(s.byMonth) == 0 && len(s.byMonthDay) == 0 && len(s.byDay) != 0 {
+		// ordinals of BYDAY are relative to the year
+		first := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return expandWeekdays(first, first.AddDate(1, 0, 0), s.byDay)
+	}
+	months := s.byMonth
+	if len(months) == 0 && len(s.byMonthDay) == 0 && len(s.byDay) == 0 {
+		months = []int{int(s.start.Month())}
+	}
+	if len(months) == 0 {
+		months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	}
+	for _, month := range months {
+		dates = append(dates, s.monthDates(year, time.Month(month))...)
+	}
+	return dates
+}
+
+func (s RRuleStrategy) monthDates(year int, month time.Month) (dates []time.Time) {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, 0)
+	switch {
+	case len(s.byDay) != 0:
+		for _, date := range expandWeekdays(first, last, s.byDay) {
+			if len(s.byMonthDay) == 0 || s.matchMonthDay(date) {
+				dates = append(dates, date)
+			}
+		}
+	case len(s.byMonthDay) != 0:
+		for date := first; date.Before(last); date = date.AddDate(0, 0, 1) {
+			if s.matchMonthDay(date) {
+				dates = append(dates, date)
+			}
+		}
+	default:
+		if s.start.Day() <= dayCountInCurrentMonth(first) {
+			dates = append(dates, first.AddDate(0, 0, s.start.Day()-1))
+		}
+	}
+	return dates
+}
+
+// expandWeekdays returns dates within [from, to), that match weekdays with ordinals relative to the range.
+func expandWeekdays(from time.Time, to time.Time, weekdays []rruleWeekday) (dates []time.Time) {
+	for _, weekday := range weekdays {
+		var matched []time.Time
+		for date := from; date.Before(to); date = date.AddDate(0, 0, 1) {
+			if date.Weekday() == weekday.weekday {
+				matched = append(matched, date)
+			}
+		}
+		switch {
+		case weekday.n == 0:
+			dates = append(dates, matched...)
+		case weekday.n > 0 && weekday.n <= len(matched):
+			dates = append(dates, matched[weekday.n-1])
+		case weekday.n < 0 && -weekday.n <= len(matched):
+			dates = append(dates, matched[len(matched)+weekday.n])
+		}
+	}
+	return dates
+}
+
+func (s RRuleStrategy) setPositions(candidates []time.Time) (selected []time.Time) {
+	if len(s.bySetPos) == 0 {
+		return candidates
+	}
+	for _, position := range s.bySetPos {
+		index := position - 1
+		if position < 0 {
+			index = len(candidates) + position
+		}
+		if index >= 0 && index < len(candidates) {
+			selected = append(selected, candidates[index])
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Before(selected[j])
+	})
+	return selected
+}
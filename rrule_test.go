@@ -0,0 +1,132 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rruleTicks(strategy Strategy, lastTickTime time.Time, n int) (ticks []time.Time) {
+	for i := 0; i < n; i++ {
+		lastTickTime = strategy.Tick(lastTickTime)
+		if lastTickTime.IsZero() {
+			break
+		}
+		ticks = append(ticks, lastTickTime)
+	}
+	return ticks
+}
+
+func Test_OnRRuleStrategyTick_ShouldReturnDTStartAsFirstOccurrence(t *testing.T) {
+	strategy, err := RRule("DTSTART:20230217T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO")
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.February, 17, 9, 0, 0, 0, time.UTC),
+		time.Date(2023, time.February, 20, 9, 0, 0, 0, time.UTC),
+		time.Date(2023, time.February, 27, 9, 0, 0, 0, time.UTC),
+	}, rruleTicks(strategy, time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), 3))
+}
+
+func Test_OnRRuleStrategyTickWithCount_ShouldStopAfterCountOccurrences(t *testing.T) {
+	strategy, err := RRule("DTSTART:20230101T100000Z\nRRULE:FREQ=DAILY;INTERVAL=2;COUNT=3")
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.January, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2023, time.January, 3, 10, 0, 0, 0, time.UTC),
+		time.Date(2023, time.January, 5, 10, 0, 0, 0, time.UTC),
+	}, rruleTicks(strategy, time.Date(2022, time.December, 1, 0, 0, 0, 0, time.UTC), 10))
+}
+
+func Test_OnRRuleStrategyTickWithUntil_ShouldStopAfterUntil(t *testing.T) {
+	strategy, err := RRule("DTSTART:20230101T100000Z\nRRULE:FREQ=MONTHLY;UNTIL=20230301T100000Z")
+	assert.NoError(t, err)
+	assert.Len(t, rruleTicks(strategy, time.Date(2022, time.December, 1, 0, 0, 0, 0, time.UTC), 10), 3)
+}
+
+func Test_OnRRuleStrategyTickWithNthWeekday_ShouldReturnNthWeekdayOfMonth(t *testing.T) {
+	strategy, err := RRule("DTSTART;TZID=Europe/Berlin:20230106T180000\nRRULE:FREQ=MONTHLY;BYDAY=-1FR")
+	assert.NoError(t, err)
+	location, _ := time.LoadLocation("Europe/Berlin")
+	lastTickTime := time.Date(2023, time.February, 1, 0, 0, 0, 0, location)
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.February, 24, 18, 0, 0, 0, location),
+		time.Date(2023, time.March, 31, 18, 0, 0, 0, location),
+	}, rruleTicks(strategy, lastTickTime, 2))
+}
+
+func Test_OnRRuleStrategyTickWithSetPosition_ShouldReturnLastWorkdayOfMonth(t *testing.T) {
+	strategy, err := RRule("DTSTART:20230131T170000Z\nRRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.February, 28, 17, 0, 0, 0, time.UTC),
+		time.Date(2023, time.March, 31, 17, 0, 0, 0, time.UTC),
+		time.Date(2023, time.April, 28, 17, 0, 0, 0, time.UTC),
+	}, rruleTicks(strategy, time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC), 3))
+}
+
+func Test_OnRRuleStrategyTickWithYearlyRule_ShouldExpandMonthsAndMonthDays(t *testing.T) {
+	strategy, err := RRule("DTSTART:20230101T000000Z\nRRULE:FREQ=YEARLY;BYMONTH=1,7;BYMONTHDAY=1,-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.July, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}, rruleTicks(strategy, time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), 4))
+}
+
+func Test_OnRRuleStrategyTickWithHourlyRule_ShouldFilterByHour(t *testing.T) {
+	strategy, err := RRule("DTSTART:20230101T083000Z\nRRULE:FREQ=HOURLY;INTERVAL=2;BYHOUR=8,12,13")
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.January, 1, 12, 30, 0, 0, time.UTC),
+		time.Date(2023, time.January, 2, 8, 30, 0, 0, time.UTC),
+	}, rruleTicks(strategy, time.Date(2023, time.January, 1, 8, 30, 0, 0, time.UTC), 2))
+}
+
+func Test_OnRRuleStrategyTickWithDates_ShouldIncludeRDateAndSkipExDate(t *testing.T) {
+	strategy, err := RRule("DTSTART:20230101T100000Z\nRRULE:FREQ=DAILY;COUNT=3\nRDATE:20230110T120000Z\nEXDATE:20230102T100000Z")
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2023, time.January, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2023, time.January, 3, 10, 0, 0, 0, time.UTC),
+		time.Date(2023, time.January, 10, 12, 0, 0, 0, time.UTC),
+	}, rruleTicks(strategy, time.Date(2022, time.December, 1, 0, 0, 0, 0, time.UTC), 10))
+}
+
+func Test_OnRRuleStrategyTickFarAfterStart_ShouldReturnNextOccurrence(t *testing.T) {
+	strategy, err := RRule("DTSTART:20000103T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(time.Date(2023, time.February, 17, 11, 39, 2, 0, time.UTC))
+	assert.Equal(t, time.Date(2023, time.February, 27, 9, 0, 0, 0, time.UTC), nextTickTime)
+}
+
+func Test_OnRRuleWithInvalidText_ShouldReturnError(t *testing.T) {
+	for _, text := range []string{
+		"RRULE:FREQ=DAILY",
+		"DTSTART:20230101T100000Z\nRRULE:INTERVAL=2",
+		"DTSTART:20230101T100000Z\nRRULE:FREQ=DAILY;COUNT=2;UNTIL=20230201T000000Z",
+		"DTSTART:20230101T100000Z\nRRULE:FREQ=DAILY;BYDAY=XX",
+		"DTSTART:20230101T100000Z\nRRULE:FREQ=DAILY;BYWEEKNO=1",
+		"DTSTART;TZID=Nowhere/Unknown:20230101T100000",
+	} {
+		_, err := RRule(text)
+		assert.Error(t, err, text)
+	}
+}
+
+func Test_OnRRuleStrategyTickWithLargeCount_ShouldReturnOccurrencesUntilCount(t *testing.T) {
+	strategy, err := RRule("DTSTART:20230101T000000Z\nRRULE:FREQ=MINUTELY;COUNT=200000")
+	assert.NoError(t, err)
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, start.Add(150001*time.Minute), strategy.Tick(start.Add(150000*time.Minute)))
+	assert.Equal(t, start.Add(199999*time.Minute), strategy.Tick(start.Add(199998*time.Minute)))
+	assert.True(t, strategy.Tick(start.Add(199999*time.Minute)).IsZero())
+}
+
+func Test_OnRRuleStrategyTickWithSparseDays_ShouldSkipNotMatchingDays(t *testing.T) {
+	strategy, err := RRule("DTSTART:20230101T000000Z\nRRULE:FREQ=SECONDLY;BYMONTH=1;BYHOUR=0;BYMINUTE=0;BYSECOND=0")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), strategy.Tick(time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)))
+}
@@ -8,6 +8,19 @@ type Strategy interface {
 	Tick(lastTickTime time.Time) (nextTickTime time.Time)
 }
 
+// ResultStrategy is a strategy, that takes into account payload execution result.
+type ResultStrategy interface {
+	Strategy
+	TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time)
+}
+
+func tickResult(strategy Strategy, lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	if s, ok := strategy.(ResultStrategy); ok {
+		return s.TickResult(lastTickTime, err)
+	}
+	return strategy.Tick(lastTickTime)
+}
+
 type StrategyFunc func(lastTickTime time.Time) (nextTickTime time.Time)
 
 func Function(f StrategyFunc) FunctionStrategy {
@@ -50,6 +63,13 @@ func (s *DelayStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
 	return s.strategy.Tick(lastTickTime)
 }
 
+func (s *DelayStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	if !s.applied {
+		return s.Tick(lastTickTime)
+	}
+	return tickResult(s.strategy, lastTickTime, err)
+}
+
 func At(time time.Time, strategy Strategy) *AtStrategy {
 	return &AtStrategy{
 		applied:  false,
@@ -74,6 +94,35 @@ func (s *AtStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
 	return s.strategy.Tick(lastTickTime)
 }
 
+func (s *AtStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	if !s.applied {
+		return s.Tick(lastTickTime)
+	}
+	return tickResult(s.strategy, lastTickTime, err)
+}
+
+func InLocation(location *time.Location, strategy Strategy) LocationStrategy {
+	return LocationStrategy{
+		location: location,
+		strategy: strategy,
+	}
+}
+
+var _ Strategy = (*LocationStrategy)(nil)
+
+type LocationStrategy struct {
+	location *time.Location
+	strategy Strategy
+}
+
+func (s LocationStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return s.strategy.Tick(lastTickTime.In(s.location))
+}
+
+func (s LocationStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	return tickResult(s.strategy, lastTickTime.In(s.location), err)
+}
+
 func Interval(interval time.Duration) IntervalStrategy {
 	return IntervalStrategy{
 		interval: interval,
@@ -106,6 +155,73 @@ func (s PeriodStrategy) Tick(_ time.Time) (nextTickTime time.Time) {
 	return time.Now().Add(s.period)
 }
 
+// Every ticks at multiples of period since Unix epoch shifted by offset, e.g. at :00, :15, :30 and :45 for 15 minutes period.
+func Every(period time.Duration, offset time.Duration) AlignedStrategy {
+	return AlignedStrategy{
+		period:   period,
+		offset:   offset,
+		midnight: false,
+	}
+}
+
+// EverySinceMidnight ticks at multiples of period since local midnight shifted by offset.
+// Alignment restarts every day, so the last period of the day may be shorter.
+func EverySinceMidnight(period time.Duration, offset time.Duration) AlignedStrategy {
+	return AlignedStrategy{
+		period:   period,
+		offset:   offset,
+		midnight: true,
+	}
+}
+
+var _ Strategy = (*AlignedStrategy)(nil)
+
+type AlignedStrategy struct {
+	period   time.Duration
+	offset   time.Duration
+	midnight bool
+}
+
+func (s AlignedStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	if s.period <= 0 {
+		return time.Time{}
+	}
+	if !s.midnight {
+		periods := floorDiv64(lastTickTime.UnixNano()-int64(s.offset), int64(s.period)) + 1
+		return time.Unix(0, periods*int64(s.period)+int64(s.offset)).In(lastTickTime.Location())
+	}
+	year, month, day := lastTickTime.Date()
+	hour, minute, second := lastTickTime.Clock()
+	wall := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second + time.Duration(lastTickTime.Nanosecond())
+	first := time.Duration(floorMod64(int64(s.offset), int64(s.period)))
+	start := first
+	if wall > first {
+		start += time.Duration(floorDiv64(int64(wall-first), int64(s.period))) * s.period
+	}
+	for i := 0; i < 3; i++ {
+		for offset := start; offset < 24*time.Hour; offset += s.period {
+			nextTickTime = time.Date(year, month, day+i, 0, 0, 0, int(offset), lastTickTime.Location())
+			if nextTickTime.After(lastTickTime) {
+				return nextTickTime
+			}
+		}
+		start = first
+	}
+	return time.Time{}
+}
+
+func floorDiv64(a int64, b int64) (c int64) {
+	return (a - floorMod64(a, b)) / b
+}
+
+func floorMod64(a int64, b int64) (c int64) {
+	c = a % b
+	if c < 0 {
+		c += b
+	}
+	return c
+}
+
 func Timetable(timetable ...Strategy) TimetableStrategy {
 	return TimetableStrategy{
 		timetable: timetable,
@@ -128,98 +244,337 @@ func (s TimetableStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time)
 	return
 }
 
+func (s TimetableStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	for _, strategy := range s.timetable {
+		strategyNextTickTime := tickResult(strategy, lastTickTime, err)
+		if nextTickTime.IsZero() || !strategyNextTickTime.IsZero() && nextTickTime.After(strategyNextTickTime) {
+			nextTickTime = strategyNextTickTime
+		}
+	}
+	return
+}
+
 func Yearly(month time.Month, day int, hour int, minute int, second int) YearlyStrategy {
+	return YearlyEach([]time.Month{month}, []int{day}, []int{hour}, []int{minute}, []int{second})
+}
+
+// YearlyEach ticks at every combination of specified months, days, hours, minutes and seconds.
+func YearlyEach(months []time.Month, days []int, hours []int, minutes []int, seconds []int) YearlyStrategy {
 	return YearlyStrategy{
-		month:  month,
-		day:    day,
-		hour:   hour,
-		minute: minute,
-		second: second,
+		months:  months,
+		days:    days,
+		hours:   hours,
+		minutes: minutes,
+		seconds: seconds,
+		policy:  dstPolicy{gap: GapShift, overlap: OverlapFirst},
 	}
 }
 
 var _ Strategy = (*YearlyStrategy)(nil)
 
 type YearlyStrategy struct {
-	month  time.Month
-	day    int
-	hour   int
-	minute int
-	second int
+	months  []time.Month
+	days    []int
+	hours   []int
+	minutes []int
+	seconds []int
+	policy  dstPolicy
+}
+
+func (s YearlyStrategy) WithGap(policy GapPolicy) YearlyStrategy {
+	s.policy.gap = policy
+	return s
+}
+
+func (s YearlyStrategy) WithOverlap(policy OverlapPolicy) YearlyStrategy {
+	s.policy.overlap = policy
+	return s
 }
 
 func (s YearlyStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
-	return nextYearPeriod(lastTickTime, s.month, s.day, s.hour, s.minute, s.second)
+	return nextYearPeriod(lastTickTime, s.months, s.days, clocks(s.hours, s.minutes, s.seconds), s.policy)
 }
 
 func Monthly(day int, hour int, minute int, second int) MonthlyStrategy {
+	return MonthlyEach([]int{day}, []int{hour}, []int{minute}, []int{second})
+}
+
+// MonthlyEach ticks at every combination of specified days, hours, minutes and seconds.
+func MonthlyEach(days []int, hours []int, minutes []int, seconds []int) MonthlyStrategy {
 	return MonthlyStrategy{
+		days:    days,
+		hours:   hours,
+		minutes: minutes,
+		seconds: seconds,
+		policy:  dstPolicy{gap: GapShift, overlap: OverlapFirst},
+	}
+}
+
+var _ Strategy = (*MonthlyStrategy)(nil)
+
+type MonthlyStrategy struct {
+	days    []int
+	hours   []int
+	minutes []int
+	seconds []int
+	policy  dstPolicy
+}
+
+func (s MonthlyStrategy) WithGap(policy GapPolicy) MonthlyStrategy {
+	s.policy.gap = policy
+	return s
+}
+
+func (s MonthlyStrategy) WithOverlap(policy OverlapPolicy) MonthlyStrategy {
+	s.policy.overlap = policy
+	return s
+}
+
+func (s MonthlyStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return nextMonthPeriod(lastTickTime, s.days, clocks(s.hours, s.minutes, s.seconds), s.policy)
+}
+
+// Quarterly ticks at specified month (1-3) of every calendar quarter.
+func Quarterly(month int, day int, hour int, minute int, second int) QuarterlyStrategy {
+	return FiscalQuarterly(time.January, month, day, hour, minute, second)
+}
+
+// FiscalQuarterly ticks at specified month (1-3) of every quarter of the fiscal year, that starts at specified month.
+func FiscalQuarterly(start time.Month, month int, day int, hour int, minute int, second int) QuarterlyStrategy {
+	return QuarterlyStrategy{
+		start:  start,
+		month:  month,
 		day:    day,
 		hour:   hour,
 		minute: minute,
 		second: second,
+		policy: dstPolicy{gap: GapShift, overlap: OverlapFirst},
 	}
 }
 
-var _ Strategy = (*MonthlyStrategy)(nil)
+var _ Strategy = (*QuarterlyStrategy)(nil)
 
-type MonthlyStrategy struct {
+type QuarterlyStrategy struct {
+	start  time.Month
+	month  int
 	day    int
 	hour   int
 	minute int
 	second int
+	policy dstPolicy
 }
 
-func (s MonthlyStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
-	return nextMonthPeriod(lastTickTime, s.day, s.hour, s.minute, s.second)
+func (s QuarterlyStrategy) WithGap(policy GapPolicy) QuarterlyStrategy {
+	s.policy.gap = policy
+	return s
 }
 
-func Weekly(day time.Weekday, hour int, minute int, second int) WeeklyStrategy {
-	return WeeklyStrategy{
+func (s QuarterlyStrategy) WithOverlap(policy OverlapPolicy) QuarterlyStrategy {
+	s.policy.overlap = policy
+	return s
+}
+
+func (s QuarterlyStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	months := make([]time.Month, 0, 4)
+	for quarter := 0; quarter < 4; quarter++ {
+		months = append(months, fiscalMonth(s.start, quarter*3+s.month))
+	}
+	return nextYearPeriod(lastTickTime, months, []int{s.day}, clocks([]int{s.hour}, []int{s.minute}, []int{s.second}), s.policy)
+}
+
+// FiscalYearly ticks at specified month (1-12) of the fiscal year, that starts at specified month.
+func FiscalYearly(start time.Month, month int, day int, hour int, minute int, second int) YearlyStrategy {
+	return Yearly(fiscalMonth(start, month), day, hour, minute, second)
+}
+
+func fiscalMonth(start time.Month, month int) (calendarMonth time.Month) {
+	return time.Month(mod(int(start)+month-2, 12) + 1)
+}
+
+// NthWeekday ticks at n-th weekday of the month, if n is negative, weekdays are counted from the end of the month.
+// Months without n-th weekday are skipped.
+func NthWeekday(n int, day time.Weekday, hour int, minute int, second int) NthWeekdayStrategy {
+	return NthWeekdayStrategy{
+		n:      n,
 		day:    day,
 		hour:   hour,
 		minute: minute,
 		second: second,
+		policy: dstPolicy{gap: GapShift, overlap: OverlapFirst},
 	}
 }
 
-var _ Strategy = (*WeeklyStrategy)(nil)
+var _ Strategy = (*NthWeekdayStrategy)(nil)
 
-type WeeklyStrategy struct {
+type NthWeekdayStrategy struct {
+	n      int
 	day    time.Weekday
 	hour   int
 	minute int
 	second int
+	policy dstPolicy
+}
+
+func (s NthWeekdayStrategy) WithGap(policy GapPolicy) NthWeekdayStrategy {
+	s.policy.gap = policy
+	return s
+}
+
+func (s NthWeekdayStrategy) WithOverlap(policy OverlapPolicy) NthWeekdayStrategy {
+	s.policy.overlap = policy
+	return s
+}
+
+func (s NthWeekdayStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return nextNthWeekdayPeriod(lastTickTime, s.n, s.day, s.hour, s.minute, s.second, s.policy)
+}
+
+func Weekly(day time.Weekday, hour int, minute int, second int) WeeklyStrategy {
+	return WeeklyEach([]time.Weekday{day}, []int{hour}, []int{minute}, []int{second})
+}
+
+// WeeklyEach ticks at every combination of specified weekdays, hours, minutes and seconds.
+func WeeklyEach(days []time.Weekday, hours []int, minutes []int, seconds []int) WeeklyStrategy {
+	return WeeklyStrategy{
+		days:    days,
+		hours:   hours,
+		minutes: minutes,
+		seconds: seconds,
+		policy:  dstPolicy{gap: GapShift, overlap: OverlapFirst},
+	}
+}
+
+var _ Strategy = (*WeeklyStrategy)(nil)
+
+type WeeklyStrategy struct {
+	days    []time.Weekday
+	hours   []int
+	minutes []int
+	seconds []int
+	policy  dstPolicy
+}
+
+func (s WeeklyStrategy) WithGap(policy GapPolicy) WeeklyStrategy {
+	s.policy.gap = policy
+	return s
+}
+
+func (s WeeklyStrategy) WithOverlap(policy OverlapPolicy) WeeklyStrategy {
+	s.policy.overlap = policy
+	return s
 }
 
 func (s WeeklyStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
-	return nextWeekPeriod(lastTickTime, s.day, s.hour, s.minute, s.second)
+	return nextWeekPeriod(lastTickTime, s.days, clocks(s.hours, s.minutes, s.seconds), s.policy)
 }
 
 func Daily(hour int, minute int, second int) DailyStrategy {
+	return DailyEach([]int{hour}, []int{minute}, []int{second})
+}
+
+// DailyEach ticks at every combination of specified hours, minutes and seconds.
+func DailyEach(hours []int, minutes []int, seconds []int) DailyStrategy {
 	return DailyStrategy{
+		hours:   hours,
+		minutes: minutes,
+		seconds: seconds,
+		policy:  dstPolicy{gap: GapShift, overlap: OverlapFirst},
+	}
+}
+
+var _ Strategy = (*DailyStrategy)(nil)
+
+type DailyStrategy struct {
+	hours   []int
+	minutes []int
+	seconds []int
+	policy  dstPolicy
+}
+
+func (s DailyStrategy) WithGap(policy GapPolicy) DailyStrategy {
+	s.policy.gap = policy
+	return s
+}
+
+func (s DailyStrategy) WithOverlap(policy OverlapPolicy) DailyStrategy {
+	s.policy.overlap = policy
+	return s
+}
+
+func (s DailyStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return nextDayPeriod(lastTickTime, clocks(s.hours, s.minutes, s.seconds), s.policy)
+}
+
+// EveryNthWeek ticks at specified weekday of every n-th ISO week.
+// Weeks are counted from the first ISO week of 1970, use WithAnchor to count them from another week.
+func EveryNthWeek(n int, day time.Weekday, hour int, minute int, second int) EveryNthWeekStrategy {
+	return EveryNthWeekStrategy{
+		n:      n,
+		anchor: isoWeekMonday(1970, 1),
+		day:    day,
 		hour:   hour,
 		minute: minute,
 		second: second,
+		policy: dstPolicy{gap: GapShift, overlap: OverlapFirst},
 	}
 }
 
-var _ Strategy = (*DailyStrategy)(nil)
+var _ Strategy = (*EveryNthWeekStrategy)(nil)
 
-type DailyStrategy struct {
+type EveryNthWeekStrategy struct {
+	n      int
+	anchor time.Time
+	day    time.Weekday
 	hour   int
 	minute int
 	second int
+	policy dstPolicy
 }
 
-func (s DailyStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
-	return nextDayPeriod(lastTickTime, s.hour, s.minute, s.second)
+// WithAnchor makes specified ISO week the first one.
+func (s EveryNthWeekStrategy) WithAnchor(year int, week int) EveryNthWeekStrategy {
+	s.anchor = isoWeekMonday(year, week)
+	return s
 }
 
+func (s EveryNthWeekStrategy) WithGap(policy GapPolicy) EveryNthWeekStrategy {
+	s.policy.gap = policy
+	return s
+}
+
+func (s EveryNthWeekStrategy) WithOverlap(policy OverlapPolicy) EveryNthWeekStrategy {
+	s.policy.overlap = policy
+	return s
+}
+
+func (s EveryNthWeekStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	if s.n <= 0 {
+		return time.Time{}
+	}
+	weekday := time.Weekday(mod(int(s.day), 7))
+	return nextCalendarTime(lastTickTime, func(date time.Time) (ok bool) {
+		days := int(date.Sub(s.anchor).Hours()) / 24
+		return date.Weekday() == weekday && mod(floorDiv(days, 7), s.n) == 0
+	}, []wallClock{clock(s.hour, s.minute, s.second)}, s.policy)
+}
+
+// isoWeekMonday returns Monday of ISO week as midnight in UTC.
+func isoWeekMonday(year int, week int) (monday time.Time) {
+	// the first ISO week contains January 4
+	january4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	return january4.AddDate(0, 0, -mod(int(january4.Weekday())-1, 7)+(week-1)*7)
+}
+
+func floorDiv(a int, b int) (c int) {
+	return (a - mod(a, b)) / b
+}
+
+// Hourly ticks at both occurrences of the repeated hour by default.
 func Hourly(minute int, second int) HourlyStrategy {
 	return HourlyStrategy{
 		minute: minute,
 		second: second,
+		policy: dstPolicy{gap: GapShift, overlap: OverlapBoth},
 	}
 }
 
@@ -228,33 +583,60 @@ var _ Strategy = (*HourlyStrategy)(nil)
 type HourlyStrategy struct {
 	minute int
 	second int
+	policy dstPolicy
+}
+
+func (s HourlyStrategy) WithGap(policy GapPolicy) HourlyStrategy {
+	s.policy.gap = policy
+	return s
+}
+
+func (s HourlyStrategy) WithOverlap(policy OverlapPolicy) HourlyStrategy {
+	s.policy.overlap = policy
+	return s
 }
 
 func (s HourlyStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
-	return nextHourPeriod(lastTickTime, s.minute, s.second)
+	return nextHourPeriod(lastTickTime, s.minute, s.second, s.policy)
 }
 
-func nextYearPeriod(dt time.Time, month time.Month, day int, hour int, minute int, second int) (ndt time.Time) {
-	year := dt.Year()
-	location := dt.Location()
-	for !ndt.After(dt) {
-		ndt = time.Date(year, month, 1, hour, minute, second, 0, location)
-		ndt = ndt.AddDate(0, 0, convertAbstractDayToDayNumber(ndt, day)-1)
-		year++
-	}
-	return ndt
+func nextYearPeriod(dt time.Time, months []time.Month, days []int, clocks []wallClock, policy dstPolicy) (ndt time.Time) {
+	return nextCalendarTime(dt, func(date time.Time) (ok bool) {
+		for _, month := range months {
+			if date.Month() == time.Month(mod(int(month)-1, 12)+1) {
+				return matchAbstractDay(date, days)
+			}
+		}
+		return false
+	}, clocks, policy)
 }
 
-func nextMonthPeriod(dt time.Time, day int, hour int, minute int, second int) (ndt time.Time) {
-	year := dt.Year()
-	month := dt.Month()
-	location := dt.Location()
-	for !ndt.After(dt) {
-		ndt = time.Date(year, month, 1, hour, minute, second, 0, location)
-		ndt = ndt.AddDate(0, 0, convertAbstractDayToDayNumber(ndt, day)-1)
-		month++
+func nextMonthPeriod(dt time.Time, days []int, clocks []wallClock, policy dstPolicy) (ndt time.Time) {
+	return nextCalendarTime(dt, func(date time.Time) (ok bool) {
+		return matchAbstractDay(date, days)
+	}, clocks, policy)
+}
+
+func matchAbstractDay(date time.Time, days []int) (ok bool) {
+	for _, day := range days {
+		if date.Day() == convertAbstractDayToDayNumber(date, day) {
+			return true
+		}
 	}
-	return ndt
+	return false
+}
+
+func nextNthWeekdayPeriod(dt time.Time, n int, weekday time.Weekday, hour int, minute int, second int, policy dstPolicy) (ndt time.Time) {
+	weekday = time.Weekday(mod(int(weekday), 7))
+	return nextCalendarTime(dt, func(date time.Time) (ok bool) {
+		if date.Weekday() != weekday {
+			return false
+		}
+		if n < 0 {
+			return (dayCountInCurrentMonth(date)-date.Day())/7+1 == -n
+		}
+		return (date.Day()-1)/7+1 == n
+	}, []wallClock{clock(hour, minute, second)}, policy)
 }
 
 func convertAbstractDayToDayNumber(dt time.Time, abstractDay int) (day int) {
@@ -288,46 +670,31 @@ func dayCountInCurrentMonth(dt time.Time) (days int) {
 	return days
 }
 
-func nextWeekPeriod(dt time.Time, weekday time.Weekday, hour int, minute int, second int) (ndt time.Time) {
-	ndt = nextDayPeriod(dt, hour, minute, second)
-	ndt = ndt.AddDate(0, 0, int(weekday-ndt.Weekday()))
-	for !ndt.After(dt) {
-		ndt = ndt.AddDate(0, 0, 7)
-	}
-	return ndt
+func nextWeekPeriod(dt time.Time, weekdays []time.Weekday, clocks []wallClock, policy dstPolicy) (ndt time.Time) {
+	return nextCalendarTime(dt, func(date time.Time) (ok bool) {
+		for _, weekday := range weekdays {
+			if date.Weekday() == time.Weekday(mod(int(weekday), 7)) {
+				return true
+			}
+		}
+		return false
+	}, clocks, policy)
 }
 
-func nextDayPeriod(dt time.Time, hour int, minute int, second int) (ndt time.Time) {
-	ndt = nextHourPeriod(dt, minute, second)
-	duration := time.Hour * time.Duration(hour-ndt.Hour())
-	duration %= 24 * time.Hour
-	if duration < 0 {
-		duration += 24 * time.Hour
-	}
-	ndt = ndt.Add(duration)
-	return ndt
+func nextDayPeriod(dt time.Time, clocks []wallClock, policy dstPolicy) (ndt time.Time) {
+	return nextCalendarTime(dt, everyDay, clocks, policy)
 }
 
-func nextHourPeriod(dt time.Time, minute int, second int) (ndt time.Time) {
-	ndt = nextMinutePeriod(dt, second)
-	duration := time.Minute * time.Duration(minute-ndt.Minute())
-	duration %= time.Hour
-	if duration < 0 {
-		duration += time.Hour
+func nextHourPeriod(dt time.Time, minute int, second int, policy dstPolicy) (ndt time.Time) {
+	clocks := make([]wallClock, 0, 24)
+	for hour := 0; hour < 24; hour++ {
+		clocks = append(clocks, clock(hour, minute, second))
 	}
-	ndt = ndt.Add(duration)
-	return ndt
+	return nextCalendarTime(dt, everyDay, clocks, policy)
 }
 
-func nextMinutePeriod(dt time.Time, second int) (ndt time.Time) {
-	ndt = nextSecond(dt)
-	duration := time.Second * time.Duration(second-ndt.Second())
-	duration %= time.Minute
-	if duration < 0 {
-		duration += time.Minute
-	}
-	ndt = ndt.Add(duration)
-	return ndt
+func everyDay(_ time.Time) (ok bool) {
+	return true
 }
 
 func nextSecond(dt time.Time) (ndt time.Time) {
@@ -53,6 +53,15 @@ func Test_OnAtStrategySecondTick_ShouldReturnTickTimeUsingSpecifiedStrategy(t *t
 	assert.Equal(t, lastTickTime.Add(time.Minute), nextTickTime)
 }
 
+func Test_OnLocationStrategyTick_ShouldEvaluateUnderlyingStrategyInSpecifiedLocation(t *testing.T) {
+	location := time.FixedZone("MSK", 3*60*60)
+	lastTickTime := time.Date(2023, time.February, 17, 7, 0, 0, 0, time.UTC)
+	strategy := InLocation(location, Daily(9, 0, 0))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.True(t, time.Date(2023, time.February, 18, 6, 0, 0, 0, time.UTC).Equal(nextTickTime))
+	assert.Equal(t, location, nextTickTime.Location())
+}
+
 func Test_OnIntervalStrategyTick_ShouldReturnTickTimeAccordingToSpecifiedPeriod(t *testing.T) {
 	lastTickTime := time.Now().Add(-2 * time.Second)
 	strategy := Interval(2 * time.Second)
@@ -69,6 +78,31 @@ func Test_OnPeriodStrategyTick_ShouldReturnTickTimeAccordingToSpecifiedPeriod(t
 	assert.InDelta(t, time.Now().Add(2*time.Second).UnixNano(), nextTickTime.UnixNano(), float64(10*time.Millisecond))
 }
 
+func Test_OnAlignedStrategyTick_ShouldReturnNearestMultipleOfPeriodSinceEpoch(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.UTC)
+	strategy := Every(15*time.Minute, 0)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 17, 11, 45, 0, 0, time.UTC), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 17, 12, 0, 0, 0, time.UTC), nextTickTime)
+}
+
+func Test_OnAlignedStrategyTickWithOffset_ShouldShiftMultiplesOfPeriod(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.UTC)
+	strategy := Every(10*time.Second, 5*time.Second)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 17, 11, 39, 5, 0, time.UTC), nextTickTime)
+}
+
+func Test_OnAlignedSinceMidnightStrategyTick_ShouldRestartAlignmentAtMidnight(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 22, 39, 2, 0, time.Local)
+	strategy := EverySinceMidnight(7*time.Hour, 30*time.Minute)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 18, 0, 30, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 18, 7, 30, 0, 0, time.Local), nextTickTime)
+}
+
 func Test_OnTimetableStrategyTick_ShouldReturnSmallestTickTimeOfUnderlyingStrategies(t *testing.T) {
 	lastTickTime := time.Now().Add(-2 * time.Second)
 	strategy := Timetable(
@@ -141,6 +175,34 @@ func Test_OnMonthlyStrategyTickWithOverflowDayNumber_ShouldRoundDayToTheBiggestD
 	assert.Equal(t, time.Date(2023, time.February, 28, 10, 0, 0, 0, time.Local), nextTickTime)
 }
 
+func Test_OnNthWeekdayStrategyTickWhenLastTickTimeLessThanTimeInConfiguration_ShouldReturnNearestTickTimeInTheFutureAccordingToConfiguration(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 13, 11, 39, 2, 0, time.Local)
+	strategy := NthWeekday(2, time.Tuesday, 10, 0, 0)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 14, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnNthWeekdayStrategyTickWhenLastTickEqualTimeInConfiguration_ShouldReturnNearestTickTimeInTheFutureAccordingToConfiguration(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 14, 10, 0, 0, 0, time.Local)
+	strategy := NthWeekday(2, time.Tuesday, 10, 0, 0)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 14, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnNthWeekdayStrategyTickWithNegativeNumber_ShouldReturnTickTimeOfTheLastWeekdayOfTheMonth(t *testing.T) {
+	lastTickTime := time.Date(2023, time.March, 1, 11, 39, 2, 0, time.Local)
+	strategy := NthWeekday(-1, time.Friday, 10, 0, 0)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 31, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnNthWeekdayStrategyTickWithFifthWeekday_ShouldSkipMonthsWithoutIt(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 1, 11, 39, 2, 0, time.Local)
+	strategy := NthWeekday(5, time.Monday, 10, 0, 0)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.May, 29, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
 func Test_OnWeeklyStrategyTickWhenLastTickTimeLessThanTimeInConfiguration_ShouldReturnNearestTickTimeInTheFutureAccordingToConfiguration(t *testing.T) {
 	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
 	strategy := Weekly(time.Saturday, 10, 0, 0)
@@ -203,3 +265,86 @@ func Test_OnHourlyStrategyTickWhenLastTickTimeGraterThanTimeInConfiguration_Shou
 	nextTickTime := strategy.Tick(lastTickTime)
 	assert.Equal(t, time.Date(2023, time.February, 17, 10, 30, 0, 0, time.Local), nextTickTime)
 }
+
+func Test_OnYearlyEachStrategyTick_ShouldReturnNearestTickTimeOfAnyCombinationOfValues(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := YearlyEach([]time.Month{time.January, time.July}, []int{1, -1}, []int{10}, []int{0}, []int{0})
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.July, 1, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.July, 31, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnMonthlyEachStrategyTick_ShouldReturnNearestTickTimeOfAnyCombinationOfValues(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := MonthlyEach([]int{1, 15}, []int{9, 18}, []int{0}, []int{0})
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 1, 9, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 1, 18, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 15, 9, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnWeeklyEachStrategyTick_ShouldReturnNearestTickTimeOfAnyCombinationOfValues(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := WeeklyEach([]time.Weekday{time.Monday, time.Wednesday, time.Friday}, []int{10}, []int{0, 30}, []int{0})
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 20, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 20, 10, 30, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 22, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnDailyEachStrategyTick_ShouldReturnNearestTickTimeOfAnyCombinationOfValues(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := DailyEach([]int{0, 6, 12, 18}, []int{0}, []int{0})
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 17, 12, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 17, 18, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 18, 0, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnQuarterlyStrategyTick_ShouldReturnNearestTickTimeInSpecifiedMonthOfTheQuarter(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := Quarterly(1, 15, 10, 0, 0)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.April, 15, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.July, 15, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnFiscalQuarterlyStrategyTick_ShouldCountQuartersFromFiscalYearStart(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := FiscalQuarterly(time.April, 3, -1, 10, 0, 0)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 31, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.June, 30, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnFiscalYearlyStrategyTick_ShouldCountMonthsFromFiscalYearStart(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := FiscalYearly(time.April, 10, 1, 10, 0, 0)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2024, time.January, 1, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnEveryNthWeekStrategyTick_ShouldReturnTickTimeInEveryNthIsoWeekFromAnchor(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := EveryNthWeek(2, time.Monday, 10, 0, 0).WithAnchor(2023, 8)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 20, 10, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 6, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnEveryNthWeekStrategyTickAtTheEndOfTheYear_ShouldKeepCadence(t *testing.T) {
+	lastTickTime := time.Date(2020, time.December, 20, 11, 39, 2, 0, time.Local)
+	strategy := EveryNthWeek(2, time.Sunday, 10, 0, 0).WithAnchor(2020, 51)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2021, time.January, 3, 10, 0, 0, 0, time.Local), nextTickTime)
+}
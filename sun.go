@@ -0,0 +1,121 @@
+package job
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	sunriseElevation  = -0.833
+	civilElevation    = -6
+	nauticalElevation = -12
+)
+
+// Sunrise ticks at sunrise shifted by offset at location with specified latitude and longitude in degrees
+// (north and east are positive). Days of polar night and polar day are skipped.
+func Sunrise(latitude float64, longitude float64, offset time.Duration) SunStrategy {
+	return sunEvent(latitude, longitude, offset, sunriseElevation, true)
+}
+
+// Sunset ticks at sunset shifted by offset, see Sunrise.
+func Sunset(latitude float64, longitude float64, offset time.Duration) SunStrategy {
+	return sunEvent(latitude, longitude, offset, sunriseElevation, false)
+}
+
+// CivilDawn ticks at the beginning of morning civil twilight, when the sun is 6° below the horizon.
+func CivilDawn(latitude float64, longitude float64, offset time.Duration) SunStrategy {
+	return sunEvent(latitude, longitude, offset, civilElevation, true)
+}
+
+// CivilDusk ticks at the end of evening civil twilight, when the sun is 6° below the horizon.
+func CivilDusk(latitude float64, longitude float64, offset time.Duration) SunStrategy {
+	return sunEvent(latitude, longitude, offset, civilElevation, false)
+}
+
+// NauticalDawn ticks at the beginning of morning nautical twilight, when the sun is 12° below the horizon.
+func NauticalDawn(latitude float64, longitude float64, offset time.Duration) SunStrategy {
+	return sunEvent(latitude, longitude, offset, nauticalElevation, true)
+}
+
+// NauticalDusk ticks at the end of evening nautical twilight, when the sun is 12° below the horizon.
+func NauticalDusk(latitude float64, longitude float64, offset time.Duration) SunStrategy {
+	return sunEvent(latitude, longitude, offset, nauticalElevation, false)
+}
+
+func sunEvent(latitude float64, longitude float64, offset time.Duration, elevation float64, rising bool) SunStrategy {
+	return SunStrategy{
+		latitude:  latitude,
+		longitude: longitude,
+		offset:    offset,
+		elevation: elevation,
+		rising:    rising,
+	}
+}
+
+var _ Strategy = (*SunStrategy)(nil)
+
+type SunStrategy struct {
+	latitude  float64
+	longitude float64
+	offset    time.Duration
+	elevation float64
+	rising    bool
+}
+
+// polar night and polar day last less than half a year even at the poles
+const sunSearchDays = 366
+
+func (s SunStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	year, month, day := lastTickTime.UTC().Date()
+	// offset can move event of the previous day after the last tick
+	date := time.Date(year, month, day-1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < sunSearchDays; i++ {
+		event, ok := s.event(date)
+		if ok {
+			event = event.Add(s.offset)
+			if event.After(lastTickTime) {
+				return event.In(lastTickTime.Location())
+			}
+		}
+		date = date.AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
+
+// event calculates time of the event on the date with sunrise equation, ok is false if the sun does not reach the elevation.
+func (s SunStrategy) event(date time.Time) (event time.Time, ok bool) {
+	const j2000 = 2451545.0
+	n := julianDay(date) - j2000 + 0.0008
+	meanSolarTime := n - s.longitude/360
+	meanAnomaly := math.Mod(357.5291+0.98560028*meanSolarTime, 360)
+	m := radians(meanAnomaly)
+	center := 1.9148*math.Sin(m) + 0.02*math.Sin(2*m) + 0.0003*math.Sin(3*m)
+	eclipticLongitude := radians(math.Mod(meanAnomaly+center+180+102.9372, 360))
+	transit := j2000 + meanSolarTime + 0.0053*math.Sin(m) - 0.0069*math.Sin(2*eclipticLongitude)
+	declination := math.Asin(math.Sin(eclipticLongitude) * math.Sin(radians(23.4397)))
+	latitude := radians(s.latitude)
+	cosHourAngle := (math.Sin(radians(s.elevation)) - math.Sin(latitude)*math.Sin(declination)) /
+		(math.Cos(latitude) * math.Cos(declination))
+	if cosHourAngle < -1 || cosHourAngle > 1 {
+		return time.Time{}, false
+	}
+	hourAngle := math.Acos(cosHourAngle) * 180 / math.Pi
+	if s.rising {
+		return fromJulianDay(transit - hourAngle/360), true
+	}
+	return fromJulianDay(transit + hourAngle/360), true
+}
+
+const unixEpochJulianDay = 2440587.5
+
+func julianDay(dt time.Time) (day float64) {
+	return float64(dt.Unix())/86400 + unixEpochJulianDay
+}
+
+func fromJulianDay(day float64) (dt time.Time) {
+	return time.Unix(0, int64((day-unixEpochJulianDay)*86400*float64(time.Second))).UTC()
+}
+
+func radians(degrees float64) (r float64) {
+	return degrees * math.Pi / 180
+}
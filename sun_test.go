@@ -0,0 +1,78 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	londonLatitude  = 51.5074
+	londonLongitude = -0.1278
+	tromsoLatitude  = 69.6496
+	tromsoLongitude = 18.9560
+)
+
+func Test_OnSunriseStrategyTick_ShouldReturnSunriseTime(t *testing.T) {
+	lastTickTime := time.Date(2023, time.June, 20, 12, 0, 0, 0, time.UTC)
+	nextTickTime := Sunrise(londonLatitude, londonLongitude, 0).Tick(lastTickTime)
+	assert.WithinDuration(t, time.Date(2023, time.June, 21, 3, 43, 0, 0, time.UTC), nextTickTime, 2*time.Minute)
+}
+
+func Test_OnSunsetStrategyTickWithOffset_ShouldReturnShiftedSunsetTime(t *testing.T) {
+	lastTickTime := time.Date(2023, time.June, 21, 0, 0, 0, 0, time.UTC)
+	nextTickTime := Sunset(londonLatitude, londonLongitude, -30*time.Minute).Tick(lastTickTime)
+	assert.WithinDuration(t, time.Date(2023, time.June, 21, 19, 51, 0, 0, time.UTC), nextTickTime, 2*time.Minute)
+}
+
+func Test_OnTwilightStrategiesTick_ShouldReturnTimesInOrder(t *testing.T) {
+	lastTickTime := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	nauticalDawn := NauticalDawn(londonLatitude, londonLongitude, 0).Tick(lastTickTime)
+	civilDawn := CivilDawn(londonLatitude, londonLongitude, 0).Tick(lastTickTime)
+	sunrise := Sunrise(londonLatitude, londonLongitude, 0).Tick(lastTickTime)
+	sunset := Sunset(londonLatitude, londonLongitude, 0).Tick(lastTickTime)
+	civilDusk := CivilDusk(londonLatitude, londonLongitude, 0).Tick(lastTickTime)
+	nauticalDusk := NauticalDusk(londonLatitude, londonLongitude, 0).Tick(lastTickTime)
+	assert.True(t, nauticalDawn.Before(civilDawn))
+	assert.True(t, civilDawn.Before(sunrise))
+	assert.True(t, sunrise.Before(sunset))
+	assert.True(t, sunset.Before(civilDusk))
+	assert.True(t, civilDusk.Before(nauticalDusk))
+	assert.Equal(t, 1, nauticalDusk.Day())
+}
+
+func Test_OnSunriseStrategyTickDuringPolarNight_ShouldReturnFirstSunriseAfterPolarNight(t *testing.T) {
+	lastTickTime := time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC)
+	nextTickTime := Sunrise(tromsoLatitude, tromsoLongitude, 0).Tick(lastTickTime)
+	assert.Equal(t, 2024, nextTickTime.Year())
+	assert.Equal(t, time.January, nextTickTime.Month())
+	assert.True(t, nextTickTime.Day() > 10 && nextTickTime.Day() < 20)
+}
+
+func Test_OnSunsetStrategyTickDuringPolarDay_ShouldReturnFirstSunsetAfterPolarDay(t *testing.T) {
+	lastTickTime := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	nextTickTime := Sunset(tromsoLatitude, tromsoLongitude, 0).Tick(lastTickTime)
+	assert.Equal(t, time.July, nextTickTime.Month())
+	assert.True(t, nextTickTime.Day() > 20)
+}
+
+func Test_OnCivilDawnStrategyTickDuringPolarNight_ShouldReturnTimeOnTheSameDay(t *testing.T) {
+	lastTickTime := time.Date(2023, time.December, 15, 0, 0, 0, 0, time.UTC)
+	nextTickTime := CivilDawn(tromsoLatitude, tromsoLongitude, 0).Tick(lastTickTime)
+	assert.Equal(t, 15, nextTickTime.Day())
+}
+
+func Test_OnSunriseStrategyTickNearThePoleInWinter_ShouldReturnSunriseInSpring(t *testing.T) {
+	lastTickTime := time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)
+	nextTickTime := Sunrise(89, 0, 0).Tick(lastTickTime)
+	assert.Equal(t, 2024, nextTickTime.Year())
+	assert.Equal(t, time.March, nextTickTime.Month())
+}
+
+func Test_OnSunriseStrategyTick_ShouldReturnTimeInLocationOfLastTickTime(t *testing.T) {
+	location := time.FixedZone("UTC+3", 3*60*60)
+	lastTickTime := time.Date(2023, time.June, 20, 12, 0, 0, 0, location)
+	nextTickTime := Sunrise(londonLatitude, londonLongitude, 0).Tick(lastTickTime)
+	assert.Equal(t, location, nextTickTime.Location())
+}
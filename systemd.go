@@ -0,0 +1,185 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var systemdShorthands = map[string]string{
+	"minutely":     "*-*-* *:*:00",
+	"hourly":       "*-*-* *:00:00",
+	"daily":        "*-*-* 00:00:00",
+	"weekly":       "Mon *-*-* 00:00:00",
+	"monthly":      "*-*-01 00:00:00",
+	"quarterly":    "*-01,04,07,10-01 00:00:00",
+	"semiannually": "*-01,07-01 00:00:00",
+	"yearly":       "*-01-01 00:00:00",
+	"annually":     "*-01-01 00:00:00",
+}
+
+var systemdWeekdays = cronRange{name: "day of week", min: 0, max: 6, names: map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	"SUNDAY": 0, "MONDAY": 1, "TUESDAY": 2, "WEDNESDAY": 3, "THURSDAY": 4, "FRIDAY": 5, "SATURDAY": 6,
+}}
+
+// OnCalendar parses systemd.time calendar event expression "[weekdays] [[year-]month-day] [hour:minute[:second]] [timezone]",
+// e.g. "Mon..Fri *-*-* 09:00:00", "*-*-01 00:00" or "*-02~01 12:00 Europe/Berlin".
+// Shorthands "minutely", "hourly", "daily", "weekly", "monthly", "quarterly", "semiannually" and "yearly" are supported.
+// Unlike crontab, weekdays and date are both required to match.
+func OnCalendar(expr string) (strategy Strategy, err error) {
+	s, err := parseOnCalendar(expr)
+	if err == nil {
+		err = s.validate()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("calendar %q: %w", expr, err)
+	}
+	return s, nil
+}
+
+func parseOnCalendar(expr string) (s CronStrategy, err error) {
+	s.dialect = "on_calendar"
+	s.expr = expr
+	s.anyDay = true
+	fields := strings.Fields(expr)
+	if len(fields) == 0 {
+		return s, errors.New("expression is empty")
+	}
+	if location, ok := parseSystemdLocation(fields[len(fields)-1]); ok && len(fields) > 1 {
+		s.location = location
+		fields = fields[:len(fields)-1]
+	}
+	if len(fields) == 1 {
+		if shorthand, ok := systemdShorthands[strings.ToLower(fields[0])]; ok {
+			fields = strings.Fields(shorthand)
+		}
+	}
+	weekdays, date, clock := "", "*-*-*", "00:00:00"
+	for i, field := range fields {
+		switch {
+		case i == 0 && !strings.ContainsAny(field, ":-~") && !strings.HasPrefix(field, "*"):
+			weekdays = field
+		case strings.Contains(field, ":") && i == len(fields)-1:
+			clock = field
+		case date == "*-*-*" && (strings.Contains(field, "-") || strings.Contains(field, "~")):
+			date = field
+		default:
+			return s, fmt.Errorf("unexpected field %q", field)
+		}
+	}
+	if err = s.parseSystemdWeekdays(weekdays); err != nil {
+		return s, err
+	}
+	if err = s.parseSystemdDate(date); err != nil {
+		return s, err
+	}
+	if err = s.parseSystemdClock(clock); err != nil {
+		return s, err
+	}
+	s.clocks = clocks(s.hours.values(), s.minutes.values(), s.seconds.values())
+	return s, nil
+}
+
+func parseSystemdLocation(field string) (location *time.Location, ok bool) {
+	if field != "UTC" && !strings.Contains(field, "/") {
+		return nil, false
+	}
+	location, err := time.LoadLocation(field)
+	return location, err == nil
+}
+
+func (s *CronStrategy) parseSystemdWeekdays(field string) (err error) {
+	if field == "" {
+		s.weekdays = 1<<7 - 1
+		return nil
+	}
+	for _, item := range strings.Split(field, ",") {
+		bits, _, err := parseCronItem(systemdRange(item), systemdWeekdays)
+		if err != nil {
+			return err
+		}
+		s.weekdays |= bits
+	}
+	return nil
+}
+
+func (s *CronStrategy) parseSystemdDate(field string) (err error) {
+	date, lastDays, fromEnd := strings.Cut(field, "~")
+	parts := strings.Split(date, "-")
+	day := ""
+	if !fromEnd {
+		day = parts[len(parts)-1]
+		parts = parts[:len(parts)-1]
+	}
+	switch len(parts) {
+	case 1:
+	case 2:
+		if err = s.parseYears(systemdRange(parts[0])); err != nil {
+			return err
+		}
+		parts = parts[1:]
+	default:
+		return fmt.Errorf("date %q is invalid", field)
+	}
+	if s.months, err = parseSystemdField(parts[0], cronMonths); err != nil {
+		return err
+	}
+	if fromEnd {
+		return s.parseSystemdLastDays(lastDays)
+	}
+	s.days, err = parseSystemdField(day, cronDays)
+	return err
+}
+
+func (s *CronStrategy) parseSystemdLastDays(field string) (err error) {
+	for _, item := range strings.Split(field, ",") {
+		rng, step, hasStep := strings.Cut(item, "/")
+		from, err := parseCronValue(rng, cronDays)
+		if err != nil {
+			return err
+		}
+		increment := from
+		if hasStep {
+			increment, err = strconv.Atoi(step)
+			if err != nil || increment <= 0 {
+				return fmt.Errorf("%s step %q is not a positive number", cronDays.name, step)
+			}
+		}
+		// "~07/1" means every day from the 7th last day to the end of month
+		for day := from; day >= 1; day -= increment {
+			s.lastDays |= 1 << uint(day-1)
+		}
+	}
+	return nil
+}
+
+func (s *CronStrategy) parseSystemdClock(field string) (err error) {
+	parts := strings.Split(field, ":")
+	switch len(parts) {
+	case 2:
+		parts = append(parts, "00")
+	case 3:
+	default:
+		return fmt.Errorf("time %q is invalid", field)
+	}
+	if s.hours, err = parseSystemdField(parts[0], cronHours); err != nil {
+		return err
+	}
+	if s.minutes, err = parseSystemdField(parts[1], cronMinutes); err != nil {
+		return err
+	}
+	s.seconds, err = parseSystemdField(parts[2], cronSeconds)
+	return err
+}
+
+func parseSystemdField(field string, r cronRange) (bits cronBits, err error) {
+	bits, _, err = parseCronField(systemdRange(field), r)
+	return bits, err
+}
+
+func systemdRange(field string) (cronField string) {
+	return strings.ReplaceAll(field, "..", "-")
+}
@@ -0,0 +1,80 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OnCalendarStrategyTickWithWeekdayRange_ShouldSkipWeekends(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy, err := OnCalendar("Mon..Fri *-*-* 09:00:00")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 20, 9, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCalendarStrategyTickWithoutSeconds_ShouldReturnFirstDayOfNextMonth(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy, err := OnCalendar("*-*-01 00:00")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.March, 1, 0, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCalendarStrategyTickWithShorthand_ShouldReturnNextHour(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy, err := OnCalendar("hourly")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 17, 12, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCalendarStrategyTickWithLastDayOperator_ShouldReturnLastDayOfMonth(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy, err := OnCalendar("*-*~01 18:00")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 28, 18, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCalendarStrategyTickWithWeekdayAndLastDays_ShouldReturnLastWeekdayOfMonth(t *testing.T) {
+	lastTickTime := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.Local)
+	strategy, err := OnCalendar("Mon *-05~07/1 10:00")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.May, 29, 10, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCalendarStrategyTickWithYearsAndRepetition_ShouldReturnMatchingTime(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy, err := OnCalendar("2024..2025-01,07-01 *:0/20")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(nextTickTime)
+	assert.Equal(t, time.Date(2024, time.January, 1, 0, 20, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnCalendarStrategyTickWithTimezone_ShouldReturnTickTimeInTimezone(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.UTC)
+	strategy, err := OnCalendar("*-*-* 09:00:00 Asia/Tokyo")
+	assert.NoError(t, err)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 18, 0, 0, 0, 0, time.UTC), nextTickTime)
+	assert.Equal(t, time.UTC, nextTickTime.Location())
+}
+
+func Test_OnCalendarWithInvalidExpression_ShouldReturnError(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"Mon..Xyz *-*-* 09:00",
+		"*-13-01 00:00",
+		"*-*-* 25:00",
+		"*-*-* 09:00 extra",
+	} {
+		_, err := OnCalendar(expr)
+		assert.Error(t, err, expr)
+	}
+}
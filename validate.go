@@ -0,0 +1,575 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Validator is a strategy, that checks its configuration.
+// All built-in strategies implement it, wrappers validate wrapped strategies too.
+type Validator interface {
+	Validate() (err error)
+}
+
+func validate(strategy Strategy) (err error) {
+	if strategy == nil {
+		return errors.New("strategy is nil")
+	}
+	if v, ok := strategy.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+func validateAll(strategies []Strategy) (err error) {
+	for _, strategy := range strategies {
+		if err = validate(strategy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateRange(name string, value int, min int, max int) (err error) {
+	if value < min || value > max {
+		return fmt.Errorf("%s %d is out of range [%d, %d]", name, value, min, max)
+	}
+	return nil
+}
+
+func validateRanges(name string, values []int, min int, max int) (err error) {
+	if len(values) == 0 {
+		return fmt.Errorf("%s is not specified", name)
+	}
+	for _, value := range values {
+		if err = validateRange(name, value, min, max); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDay checks day of month, that is counted from the end of the month, if it is negative.
+func validateDay(day int) (err error) {
+	if day == 0 || day < -31 || day > 31 {
+		return fmt.Errorf("day %d is out of range [-31, -1] or [1, 31]", day)
+	}
+	return nil
+}
+
+func validateDays(days []int) (err error) {
+	if len(days) == 0 {
+		return errors.New("day is not specified")
+	}
+	for _, day := range days {
+		if err = validateDay(day); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateWeekdays(weekdays []time.Weekday) (err error) {
+	for _, weekday := range weekdays {
+		if err = validateRange("weekday", int(weekday), int(time.Sunday), int(time.Saturday)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateClock(hour int, minute int, second int) (err error) {
+	if err = validateRange("hour", hour, 0, 23); err != nil {
+		return err
+	}
+	if err = validateRange("minute", minute, 0, 59); err != nil {
+		return err
+	}
+	return validateRange("second", second, 0, 59)
+}
+
+func validateClocks(hours []int, minutes []int, seconds []int) (err error) {
+	if err = validateRanges("hour", hours, 0, 23); err != nil {
+		return err
+	}
+	if err = validateRanges("minute", minutes, 0, 59); err != nil {
+		return err
+	}
+	return validateRanges("second", seconds, 0, 59)
+}
+
+func (s Schedule) Validate() (err error) {
+	return validate(s.Strategy)
+}
+
+func (s FunctionStrategy) Validate() (err error) {
+	if s.f == nil {
+		return errors.New("function: function is nil")
+	}
+	return nil
+}
+
+func (s *DelayStrategy) Validate() (err error) {
+	if s.delay < 0 {
+		return fmt.Errorf("delay: delay %s is negative", s.delay)
+	}
+	if err = validate(s.strategy); err != nil {
+		return fmt.Errorf("delay: %w", err)
+	}
+	return nil
+}
+
+func (s *AtStrategy) Validate() (err error) {
+	if err = validate(s.strategy); err != nil {
+		return fmt.Errorf("at: %w", err)
+	}
+	return nil
+}
+
+func (s LocationStrategy) Validate() (err error) {
+	if s.location == nil {
+		return errors.New("location: location is nil")
+	}
+	if err = validate(s.strategy); err != nil {
+		return fmt.Errorf("location: %w", err)
+	}
+	return nil
+}
+
+func (s IntervalStrategy) Validate() (err error) {
+	if s.interval <= 0 {
+		return fmt.Errorf("interval: interval %s is not positive", s.interval)
+	}
+	return nil
+}
+
+func (s PeriodStrategy) Validate() (err error) {
+	if s.period <= 0 {
+		return fmt.Errorf("period: period %s is not positive", s.period)
+	}
+	return nil
+}
+
+func (s AlignedStrategy) Validate() (err error) {
+	if s.period <= 0 {
+		return fmt.Errorf("every: period %s is not positive", s.period)
+	}
+	return nil
+}
+
+func (s TimetableStrategy) Validate() (err error) {
+	if len(s.timetable) == 0 {
+		return errors.New("timetable: timetable is empty")
+	}
+	if err = validateAll(s.timetable); err != nil {
+		return fmt.Errorf("timetable: %w", err)
+	}
+	return nil
+}
+
+func (s YearlyStrategy) Validate() (err error) {
+	if err = s.validate(); err != nil {
+		return fmt.Errorf("yearly: %w", err)
+	}
+	return nil
+}
+
+func (s YearlyStrategy) validate() (err error) {
+	if len(s.months) == 0 {
+		return errors.New("month is not specified")
+	}
+	for _, month := range s.months {
+		if err = validateRange("month", int(month), int(time.January), int(time.December)); err != nil {
+			return err
+		}
+	}
+	if err = validateDays(s.days); err != nil {
+		return err
+	}
+	for _, day := range s.days {
+		if !s.hasDay(day) {
+			names := make([]string, 0, len(s.months))
+			for _, month := range s.months {
+				names = append(names, month.String())
+			}
+			return fmt.Errorf("%s has no day %d", strings.Join(names, " or "), day)
+		}
+	}
+	return validateClocks(s.hours, s.minutes, s.seconds)
+}
+
+// hasDay reports whether the day exists in at least one of the months.
+func (s YearlyStrategy) hasDay(day int) (ok bool) {
+	for _, month := range s.months {
+		// leap year contains all possible dates
+		days := dayCountInCurrentMonth(time.Date(2000, month, 1, 0, 0, 0, 0, time.UTC))
+		if day <= days && -day <= days {
+			return true
+		}
+	}
+	return false
+}
+
+func (s MonthlyStrategy) Validate() (err error) {
+	if err = validateDays(s.days); err != nil {
+		return fmt.Errorf("monthly: %w", err)
+	}
+	if err = validateClocks(s.hours, s.minutes, s.seconds); err != nil {
+		return fmt.Errorf("monthly: %w", err)
+	}
+	return nil
+}
+
+func (s QuarterlyStrategy) Validate() (err error) {
+	if err = s.validate(); err != nil {
+		return fmt.Errorf("quarterly: %w", err)
+	}
+	return nil
+}
+
+func (s QuarterlyStrategy) validate() (err error) {
+	if err = validateRange("start month", int(s.start), int(time.January), int(time.December)); err != nil {
+		return err
+	}
+	if err = validateRange("month of quarter", s.month, 1, 3); err != nil {
+		return err
+	}
+	if err = validateDay(s.day); err != nil {
+		return err
+	}
+	return validateClock(s.hour, s.minute, s.second)
+}
+
+func (s NthWeekdayStrategy) Validate() (err error) {
+	if err = s.validate(); err != nil {
+		return fmt.Errorf("nth weekday: %w", err)
+	}
+	return nil
+}
+
+func (s NthWeekdayStrategy) validate() (err error) {
+	if s.n == 0 || s.n < -5 || s.n > 5 {
+		return fmt.Errorf("n %d is out of range [-5, -1] or [1, 5]", s.n)
+	}
+	if err = validateWeekdays([]time.Weekday{s.day}); err != nil {
+		return err
+	}
+	return validateClock(s.hour, s.minute, s.second)
+}
+
+func (s WeeklyStrategy) Validate() (err error) {
+	if err = s.validate(); err != nil {
+		return fmt.Errorf("weekly: %w", err)
+	}
+	return nil
+}
+
+func (s WeeklyStrategy) validate() (err error) {
+	if len(s.days) == 0 {
+		return errors.New("weekday is not specified")
+	}
+	if err = validateWeekdays(s.days); err != nil {
+		return err
+	}
+	return validateClocks(s.hours, s.minutes, s.seconds)
+}
+
+func (s DailyStrategy) Validate() (err error) {
+	if err = validateClocks(s.hours, s.minutes, s.seconds); err != nil {
+		return fmt.Errorf("daily: %w", err)
+	}
+	return nil
+}
+
+func (s EveryNthWeekStrategy) Validate() (err error) {
+	if err = s.validate(); err != nil {
+		return fmt.Errorf("every nth week: %w", err)
+	}
+	return nil
+}
+
+func (s EveryNthWeekStrategy) validate() (err error) {
+	if s.n <= 0 {
+		return fmt.Errorf("n %d is not positive", s.n)
+	}
+	if err = validateWeekdays([]time.Weekday{s.day}); err != nil {
+		return err
+	}
+	return validateClock(s.hour, s.minute, s.second)
+}
+
+func (s HourlyStrategy) Validate() (err error) {
+	if err = validateClock(0, s.minute, s.second); err != nil {
+		return fmt.Errorf("hourly: %w", err)
+	}
+	return nil
+}
+
+func (s CronStrategy) Validate() (err error) {
+	name := "cron"
+	switch s.dialect {
+	case "quartz":
+		name = "quartz"
+	case "on_calendar":
+		name = "calendar"
+	}
+	if err = s.validate(); err != nil {
+		return fmt.Errorf("%s %q: %w", name, s.expr, err)
+	}
+	return nil
+}
+
+func (s CronStrategy) validate() (err error) {
+	if s.seconds == 0 || s.minutes == 0 || s.hours == 0 || s.months == 0 {
+		return errors.New("expression has empty field")
+	}
+	year := 2000
+	if s.years != nil {
+		year = s.lastYear
+		for y := range s.years {
+			year = min(year, y)
+		}
+	}
+	// search from the first year covers all combinations of dates and weekdays
+	if s.Tick(time.Date(year-1, time.December, 31, 23, 59, 59, 0, time.UTC)).IsZero() {
+		return errors.New("expression never matches")
+	}
+	return nil
+}
+
+func (s RRuleStrategy) Validate() (err error) {
+	if err = s.validate(); err != nil {
+		return fmt.Errorf("rrule: %w", err)
+	}
+	return nil
+}
+
+func (s RRuleStrategy) validate() (err error) {
+	if s.start.IsZero() {
+		return errors.New("DTSTART is not specified")
+	}
+	if s.interval <= 0 {
+		return fmt.Errorf("INTERVAL %d is not positive", s.interval)
+	}
+	if len(s.byMonthDay) == 0 || len(s.byMonth) == 0 {
+		return nil
+	}
+	for _, month := range s.byMonth {
+		// leap year contains all possible dates
+		days := dayCountInCurrentMonth(time.Date(2000, time.Month(month), 1, 0, 0, 0, 0, time.UTC))
+		for _, day := range s.byMonthDay {
+			if day <= days && -day <= days {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("BYMONTHDAY %v does not match any day of BYMONTH %v", s.byMonthDay, s.byMonth)
+}
+
+func (s *JitterStrategy) Validate() (err error) {
+	if s.max < s.min {
+		return fmt.Errorf("jitter: deviation range [%s, %s) is empty", s.min, s.max)
+	}
+	if err = validate(s.strategy); err != nil {
+		return fmt.Errorf("jitter: %w", err)
+	}
+	return nil
+}
+
+func (s *BackoffStrategy) Validate() (err error) {
+	if err = s.validate(); err != nil {
+		return fmt.Errorf("backoff: %w", err)
+	}
+	return nil
+}
+
+// validate accepts any delay settings, see Backoff.
+func (s *BackoffStrategy) validate() (err error) {
+	return validate(s.strategy)
+}
+
+func (s *TimesStrategy) Validate() (err error) {
+	if s.n <= 0 {
+		return fmt.Errorf("times: n %d is not positive", s.n)
+	}
+	if err = validate(s.strategy); err != nil {
+		return fmt.Errorf("times: %w", err)
+	}
+	return nil
+}
+
+func (s *OnceStrategy) Validate() (err error) {
+	if s.time.IsZero() {
+		return errors.New("once: time is not specified")
+	}
+	return nil
+}
+
+func (s *OnStartStrategy) Validate() (err error) {
+	return nil
+}
+
+func (s UntilStrategy) Validate() (err error) {
+	if err = validate(s.strategy); err != nil {
+		return fmt.Errorf("until: %w", err)
+	}
+	return nil
+}
+
+func (s AfterStrategy) Validate() (err error) {
+	if err = validate(s.strategy); err != nil {
+		return fmt.Errorf("after: %w", err)
+	}
+	return nil
+}
+
+func (s ActiveHoursStrategy) Validate() (err error) {
+	if err = s.validate(); err != nil {
+		return fmt.Errorf("active hours: %w", err)
+	}
+	return nil
+}
+
+func (s ActiveHoursStrategy) validate() (err error) {
+	for _, offset := range []time.Duration{s.from, s.to} {
+		if offset < 0 || offset >= 24*time.Hour {
+			return fmt.Errorf("offset %s is out of range [0s, 24h0m0s)", offset)
+		}
+	}
+	if s.from == s.to {
+		return errors.New("window is empty")
+	}
+	if err = validateWeekdays(s.weekdays); err != nil {
+		return err
+	}
+	return validate(s.strategy)
+}
+
+func (s IntersectStrategy) Validate() (err error) {
+	if err = validateAll(s.strategies); err != nil {
+		return fmt.Errorf("intersect: %w", err)
+	}
+	return nil
+}
+
+func (s ExceptStrategy) Validate() (err error) {
+	if err = validate(s.base); err != nil {
+		return fmt.Errorf("except: %w", err)
+	}
+	if err = validateAll(s.excluded); err != nil {
+		return fmt.Errorf("except: %w", err)
+	}
+	return nil
+}
+
+func (s BusinessDaysStrategy) Validate() (err error) {
+	if s.calendar == nil {
+		return errors.New("business days: calendar is nil")
+	}
+	if err = validate(s.strategy); err != nil {
+		return fmt.Errorf("business days: %w", err)
+	}
+	return nil
+}
+
+func (s *AdjustStrategy) Validate() (err error) {
+	if s.calendar == nil {
+		return errors.New("adjust: calendar is nil")
+	}
+	if err = validateRange("adjustment", int(s.adjustment), int(Following), int(ModifiedFollowing)); err != nil {
+		return fmt.Errorf("adjust: %w", err)
+	}
+	if err = validate(s.strategy); err != nil {
+		return fmt.Errorf("adjust: %w", err)
+	}
+	return nil
+}
+
+func (s NthBusinessDayStrategy) Validate() (err error) {
+	if s.calendar == nil {
+		return errors.New("nth business day: calendar is nil")
+	}
+	if err = validateDay(s.n); err != nil {
+		return fmt.Errorf("nth business day: %w", err)
+	}
+	if err = validateClock(s.hour, s.minute, s.second); err != nil {
+		return fmt.Errorf("nth business day: %w", err)
+	}
+	return nil
+}
+
+func (s RandomStrategy) Validate() (err error) {
+	if s.length <= 0 {
+		return fmt.Errorf("random: length %s is not positive", s.length)
+	}
+	if err = validate(s.windows); err != nil {
+		return fmt.Errorf("random: %w", err)
+	}
+	return nil
+}
+
+func (s SunStrategy) Validate() (err error) {
+	if s.latitude < -90 || s.latitude > 90 {
+		return fmt.Errorf("sun: latitude %v is out of range [-90, 90]", s.latitude)
+	}
+	if s.longitude < -180 || s.longitude > 180 {
+		return fmt.Errorf("sun: longitude %v is out of range [-180, 180]", s.longitude)
+	}
+	return nil
+}
+
+// NewYearly is Yearly, that returns error for invalid arguments, e.g. February 30.
+func NewYearly(month time.Month, day int, hour int, minute int, second int) (strategy YearlyStrategy, err error) {
+	strategy = Yearly(month, day, hour, minute, second)
+	if err = strategy.Validate(); err != nil {
+		return YearlyStrategy{}, err
+	}
+	return strategy, nil
+}
+
+// NewMonthly is Monthly, that returns error for invalid arguments.
+func NewMonthly(day int, hour int, minute int, second int) (strategy MonthlyStrategy, err error) {
+	strategy = Monthly(day, hour, minute, second)
+	if err = strategy.Validate(); err != nil {
+		return MonthlyStrategy{}, err
+	}
+	return strategy, nil
+}
+
+// NewWeekly is Weekly, that returns error for invalid arguments.
+func NewWeekly(day time.Weekday, hour int, minute int, second int) (strategy WeeklyStrategy, err error) {
+	strategy = Weekly(day, hour, minute, second)
+	if err = strategy.Validate(); err != nil {
+		return WeeklyStrategy{}, err
+	}
+	return strategy, nil
+}
+
+// NewDaily is Daily, that returns error for invalid arguments.
+func NewDaily(hour int, minute int, second int) (strategy DailyStrategy, err error) {
+	strategy = Daily(hour, minute, second)
+	if err = strategy.Validate(); err != nil {
+		return DailyStrategy{}, err
+	}
+	return strategy, nil
+}
+
+// NewHourly is Hourly, that returns error for invalid arguments.
+func NewHourly(minute int, second int) (strategy HourlyStrategy, err error) {
+	strategy = Hourly(minute, second)
+	if err = strategy.Validate(); err != nil {
+		return HourlyStrategy{}, err
+	}
+	return strategy, nil
+}
+
+// NewTimetable is Timetable, that returns error, if timetable is empty or contains invalid strategy.
+func NewTimetable(timetable ...Strategy) (strategy TimetableStrategy, err error) {
+	strategy = Timetable(timetable...)
+	if err = strategy.Validate(); err != nil {
+		return TimetableStrategy{}, err
+	}
+	return strategy, nil
+}
@@ -0,0 +1,93 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OnValidStrategy_ShouldReturnNoError(t *testing.T) {
+	assert.NoError(t, Daily(10, 0, 0).Validate())
+	assert.NoError(t, Monthly(-1, 0, 0, 0).Validate())
+	assert.NoError(t, Yearly(time.February, 29, 0, 0, 0).Validate())
+	assert.NoError(t, YearlyEach([]time.Month{time.February, time.March}, []int{30}, []int{0}, []int{0}, []int{0}).Validate())
+	assert.NoError(t, Timetable(Daily(10, 0, 0), Weekly(time.Saturday, 12, 0, 0)).Validate())
+	assert.NoError(t, Schedule{Strategy: Delay(time.Minute, Interval(time.Hour))}.Validate())
+}
+
+func Test_OnOutOfRangeClock_ShouldReturnError(t *testing.T) {
+	assert.EqualError(t, Daily(25, 0, 0).Validate(), "daily: hour 25 is out of range [0, 23]")
+	assert.EqualError(t, Daily(10, 61, 0).Validate(), "daily: minute 61 is out of range [0, 59]")
+	assert.Error(t, Hourly(0, 60).Validate())
+}
+
+func Test_OnOutOfRangeDay_ShouldReturnError(t *testing.T) {
+	assert.EqualError(t, Monthly(40, 0, 0, 0).Validate(), "monthly: day 40 is out of range [-31, -1] or [1, 31]")
+	assert.Error(t, Monthly(0, 0, 0, 0).Validate())
+}
+
+func Test_OnOutOfRangeWeekday_ShouldReturnError(t *testing.T) {
+	assert.EqualError(t, Weekly(time.Weekday(9), 0, 0, 0).Validate(), "weekly: weekday 9 is out of range [0, 6]")
+}
+
+func Test_OnImpossibleDate_ShouldReturnError(t *testing.T) {
+	assert.EqualError(t, Yearly(time.February, 30, 0, 0, 0).Validate(), "yearly: February has no day 30")
+	assert.Error(t, Yearly(time.April, -31, 0, 0, 0).Validate())
+	assert.EqualError(t, YearlyEach([]time.Month{time.February, time.April}, []int{31}, []int{0}, []int{0}, []int{0}).Validate(), "yearly: February or April has no day 31")
+}
+
+func Test_OnEmptyTimetable_ShouldReturnError(t *testing.T) {
+	assert.EqualError(t, Timetable().Validate(), "timetable: timetable is empty")
+}
+
+func Test_OnInvalidWrappedStrategy_ShouldReturnError(t *testing.T) {
+	err := Delay(time.Minute, Timetable(Daily(10, 0, 0), Daily(24, 0, 0))).Validate()
+	assert.EqualError(t, err, "delay: timetable: daily: hour 24 is out of range [0, 23]")
+}
+
+func Test_OnCheckedConstructor_ShouldReturnError(t *testing.T) {
+	_, err := NewDaily(25, 61, 0)
+	assert.Error(t, err)
+	_, err = NewYearly(time.February, 30, 0, 0, 0)
+	assert.Error(t, err)
+	_, err = NewTimetable()
+	assert.Error(t, err)
+	strategy, err := NewMonthly(1, 10, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, Monthly(1, 10, 0, 0), strategy)
+}
+
+func Test_OnDecodingInvalidStrategy_ShouldReturnError(t *testing.T) {
+	_, err := UnmarshalStrategy([]byte(`{"type":"timetable","strategies":[]}`))
+	assert.Error(t, err)
+}
+
+func Test_OnValidateBackoffStrategy_ShouldAcceptAnyDelaysAndCheckWrappedStrategy(t *testing.T) {
+	assert.NoError(t, Backoff(0, 2, time.Minute, Interval(time.Hour)).Validate())
+	assert.NoError(t, Backoff(time.Second, 0.5, time.Minute, Interval(time.Hour)).Validate())
+	assert.NoError(t, Backoff(time.Second, 2, 0, Interval(time.Hour)).Validate())
+	assert.Error(t, Backoff(time.Second, 2, time.Minute, Once(time.Time{})).Validate())
+}
+
+func Test_OnValidateOfParsedStrategy_ShouldReturnErrorForImpossibleSchedule(t *testing.T) {
+	cron, err := Cron("*/15 9-18 * * MON-FRI")
+	require.NoError(t, err)
+	assert.NoError(t, cron.(Validator).Validate())
+	quartz, err := Quartz("0 0 10 ? * 3#2 2020-2030")
+	require.NoError(t, err)
+	assert.NoError(t, quartz.(Validator).Validate())
+
+	rrule, err := RRule("DTSTART:20230101T100000Z\nRRULE:FREQ=YEARLY;BYMONTH=2,4;BYMONTHDAY=31")
+	require.NoError(t, err)
+	assert.Error(t, rrule.Validate())
+	rrule, err = RRule("DTSTART:20230101T100000Z\nRRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29")
+	require.NoError(t, err)
+	assert.NoError(t, rrule.Validate())
+	assert.Error(t, RRuleStrategy{}.Validate())
+
+	assert.NoError(t, Once(time.Date(2023, time.February, 17, 11, 39, 2, 0, time.UTC)).Validate())
+	assert.Error(t, Once(time.Time{}).Validate())
+	assert.Error(t, Timetable(Daily(10, 0, 0), Once(time.Time{})).Validate())
+}
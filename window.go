@@ -0,0 +1,76 @@
+package job
+
+import (
+	"time"
+)
+
+// ActiveHours restricts the strategy ticks to the daily window [from, to),
+// where from and to are durations since midnight, and window wraps midnight if from is greater than to.
+// If weekdays are specified, window opens only at these days.
+// Tick out of the window is moved to the next window opening.
+func ActiveHours(from time.Duration, to time.Duration, strategy Strategy, weekdays ...time.Weekday) ActiveHoursStrategy {
+	return ActiveHoursStrategy{
+		from:     from,
+		to:       to,
+		weekdays: weekdays,
+		strategy: strategy,
+	}
+}
+
+var _ ResultStrategy = (*ActiveHoursStrategy)(nil)
+
+type ActiveHoursStrategy struct {
+	from     time.Duration
+	to       time.Duration
+	weekdays []time.Weekday
+	strategy Strategy
+}
+
+func (s ActiveHoursStrategy) Tick(lastTickTime time.Time) (nextTickTime time.Time) {
+	return s.fit(s.strategy.Tick(lastTickTime))
+}
+
+func (s ActiveHoursStrategy) TickResult(lastTickTime time.Time, err error) (nextTickTime time.Time) {
+	return s.fit(tickResult(s.strategy, lastTickTime, err))
+}
+
+func (s ActiveHoursStrategy) fit(tickTime time.Time) (nextTickTime time.Time) {
+	if tickTime.IsZero() || s.contains(tickTime) {
+		return tickTime
+	}
+	return s.nextOpening(tickTime)
+}
+
+func (s ActiveHoursStrategy) contains(dt time.Time) (ok bool) {
+	hour, minute, second := dt.Clock()
+	offset := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second + time.Duration(dt.Nanosecond())
+	weekday := dt.Weekday()
+	if s.from <= s.to {
+		return s.from <= offset && offset < s.to && s.opens(weekday)
+	}
+	// window wraps midnight, so it could be opened yesterday
+	return s.from <= offset && s.opens(weekday) || offset < s.to && s.opens((weekday+6)%7)
+}
+
+func (s ActiveHoursStrategy) nextOpening(dt time.Time) (opening time.Time) {
+	year, month, day := dt.Date()
+	for i := 0; i <= 7; i++ {
+		opening = time.Date(year, month, day+i, 0, 0, 0, int(s.from), dt.Location())
+		if opening.After(dt) && s.opens(opening.Weekday()) {
+			return opening
+		}
+	}
+	return time.Time{}
+}
+
+func (s ActiveHoursStrategy) opens(weekday time.Weekday) (ok bool) {
+	if len(s.weekdays) == 0 {
+		return true
+	}
+	for _, day := range s.weekdays {
+		if day == weekday {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OnActiveHoursStrategyTickWithinWindow_ShouldReturnTickTimeOfUnderlyingStrategy(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 11, 39, 2, 0, time.Local)
+	strategy := ActiveHours(9*time.Hour, 18*time.Hour, Interval(5*time.Minute))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 17, 11, 44, 2, 0, time.Local), nextTickTime)
+}
+
+func Test_OnActiveHoursStrategyTickOutOfWindow_ShouldReturnNextWindowOpening(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 17, 58, 0, 0, time.Local)
+	strategy := ActiveHours(9*time.Hour, 18*time.Hour, Interval(5*time.Minute))
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 18, 9, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnActiveHoursStrategyTickOutOfWindowWithWeekdays_ShouldReturnWindowOpeningAtNextSpecifiedWeekday(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 17, 58, 0, 0, time.Local)
+	strategy := ActiveHours(9*time.Hour, 18*time.Hour, Interval(5*time.Minute), time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 20, 9, 0, 0, 0, time.Local), nextTickTime)
+}
+
+func Test_OnActiveHoursStrategyTickWithinWindowWrappingMidnight_ShouldReturnTickTimeOfUnderlyingStrategy(t *testing.T) {
+	lastTickTime := time.Date(2023, time.February, 17, 23, 58, 0, 0, time.Local)
+	strategy := ActiveHours(22*time.Hour, 6*time.Hour, Interval(5*time.Minute), time.Friday)
+	nextTickTime := strategy.Tick(lastTickTime)
+	assert.Equal(t, time.Date(2023, time.February, 18, 0, 3, 0, 0, time.Local), nextTickTime)
+	nextTickTime = strategy.Tick(time.Date(2023, time.February, 18, 5, 58, 0, 0, time.Local))
+	assert.Equal(t, time.Date(2023, time.February, 24, 22, 0, 0, 0, time.Local), nextTickTime)
+}